@@ -0,0 +1,229 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+// btcNetParams are the address encoding parameters of a coin.
+type btcNetParams struct {
+	pubkeyHashAddrID byte
+	scriptHashAddrID byte
+	// bech32HRPs are the human-readable parts of segwit addresses. The first one is used to encode
+	// addresses, the others are accepted as well, e.g. for regtest.
+	bech32HRPs []string
+}
+
+var btcNetParamsByCoin = map[messages.BTCCoin]*btcNetParams{
+	messages.BTCCoin_BTC:  {pubkeyHashAddrID: 0x00, scriptHashAddrID: 0x05, bech32HRPs: []string{"bc"}},
+	messages.BTCCoin_TBTC: {pubkeyHashAddrID: 0x6f, scriptHashAddrID: 0xc4, bech32HRPs: []string{"tb", "bcrt"}},
+	messages.BTCCoin_LTC:  {pubkeyHashAddrID: 0x30, scriptHashAddrID: 0x32, bech32HRPs: []string{"ltc"}},
+	messages.BTCCoin_TLTC: {pubkeyHashAddrID: 0x6f, scriptHashAddrID: 0x3a, bech32HRPs: []string{"tltc", "rltc"}},
+}
+
+func netParams(coin messages.BTCCoin) (*btcNetParams, error) {
+	params, ok := btcNetParamsByCoin[coin]
+	if !ok {
+		return nil, fmt.Errorf("unsupported coin: %s", coin)
+	}
+	return params, nil
+}
+
+// addressCoins returns the coins other than the given one an address could belong to, judging by
+// its prefix, to give a helpful error if it does not match the expected coin.
+func addressCoins(address string, exclude messages.BTCCoin) []string {
+	var coins []string
+	hrp := ""
+	if i := strings.LastIndexByte(address, '1'); i > 0 {
+		hrp = strings.ToLower(address[:i])
+	}
+	decoded, version, base58Err := base58.CheckDecode(address)
+	for _, coin := range []messages.BTCCoin{
+		messages.BTCCoin_BTC, messages.BTCCoin_TBTC, messages.BTCCoin_LTC, messages.BTCCoin_TLTC,
+	} {
+		params := btcNetParamsByCoin[coin]
+		matches := base58Err == nil && len(decoded) == 20 &&
+			(version == params.pubkeyHashAddrID || version == params.scriptHashAddrID)
+		for _, paramsHRP := range params.bech32HRPs {
+			matches = matches || hrp == paramsHRP
+		}
+		if matches && coin != exclude {
+			coins = append(coins, coin.String())
+		}
+	}
+	return coins
+}
+
+// decodeAddress decodes a base58check (P2PKH, P2SH), bech32 (segwit v0) or bech32m (segwit v1+)
+// address of the given coin into the output type and payload expected by the device.
+func decodeAddress(coin messages.BTCCoin, address string) (messages.BTCOutputType, []byte, error) {
+	params, err := netParams(coin)
+	if err != nil {
+		return 0, nil, err
+	}
+	errWrongNetwork := func() error {
+		if coins := addressCoins(address, coin); len(coins) != 0 {
+			return fmt.Errorf("address %s is for %s, expected %s",
+				address, strings.Join(coins, "/"), coin)
+		}
+		return fmt.Errorf("invalid address: %s", address)
+	}
+
+	if hrp, data, bech32Version, err := bech32.DecodeGeneric(address); err == nil {
+		hrpMatches := false
+		for _, paramsHRP := range params.bech32HRPs {
+			hrpMatches = hrpMatches || hrp == paramsHRP
+		}
+		if !hrpMatches {
+			return 0, nil, errWrongNetwork()
+		}
+		if len(data) == 0 {
+			return 0, nil, fmt.Errorf("invalid address: %s", address)
+		}
+		witnessVersion := data[0]
+		program, err := bech32.ConvertBits(data[1:], 5, 8, false)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid address: %s", address)
+		}
+		// BIP350: segwit v0 uses bech32, later versions use bech32m.
+		if (witnessVersion == 0) != (bech32Version == bech32.Version0) {
+			return 0, nil, fmt.Errorf("invalid address checksum: %s", address)
+		}
+		switch {
+		case witnessVersion == 0 && len(program) == 20:
+			return messages.BTCOutputType_P2WPKH, program, nil
+		case witnessVersion == 0 && len(program) == 32:
+			return messages.BTCOutputType_P2WSH, program, nil
+		case witnessVersion == 1 && len(program) == 32:
+			return messages.BTCOutputType_P2TR, program, nil
+		default:
+			return 0, nil, fmt.Errorf("unsupported address type: %s", address)
+		}
+	}
+
+	decoded, version, err := base58.CheckDecode(address)
+	if err != nil || len(decoded) != 20 {
+		return 0, nil, errWrongNetwork()
+	}
+	switch version {
+	case params.pubkeyHashAddrID:
+		return messages.BTCOutputType_P2PKH, decoded, nil
+	case params.scriptHashAddrID:
+		return messages.BTCOutputType_P2SH, decoded, nil
+	default:
+		return 0, nil, errWrongNetwork()
+	}
+}
+
+// encodeAddress is the inverse of decodeAddress, returning the address of a pubkey script.
+func encodeAddress(coin messages.BTCCoin, pkScript []byte) (string, error) {
+	params, err := netParams(coin)
+	if err != nil {
+		return "", err
+	}
+	outputType, payload, err := outputTypeAndPayload(pkScript)
+	if err != nil {
+		return "", err
+	}
+	segwit := func(witnessVersion byte) (string, error) {
+		data, err := bech32.ConvertBits(payload, 8, 5, true)
+		if err != nil {
+			return "", err
+		}
+		data = append([]byte{witnessVersion}, data...)
+		// BIP350: segwit v0 uses bech32, later versions use bech32m.
+		if witnessVersion == 0 {
+			return bech32.Encode(params.bech32HRPs[0], data)
+		}
+		return bech32.EncodeM(params.bech32HRPs[0], data)
+	}
+	switch outputType {
+	case messages.BTCOutputType_P2PKH:
+		return base58.CheckEncode(payload, params.pubkeyHashAddrID), nil
+	case messages.BTCOutputType_P2SH:
+		return base58.CheckEncode(payload, params.scriptHashAddrID), nil
+	case messages.BTCOutputType_P2WPKH, messages.BTCOutputType_P2WSH:
+		return segwit(0)
+	case messages.BTCOutputType_P2TR:
+		return segwit(1)
+	default:
+		return "", fmt.Errorf("unsupported output type: %s", outputType)
+	}
+}
+
+// simpleAddress returns the single-sig address of the account at the given keypath.
+func simpleAddress(
+	coin messages.BTCCoin,
+	simpleType messages.BTCScriptConfig_SimpleType,
+	account *accountXPub,
+	keypath []uint32,
+) (string, error) {
+	pubkey, err := account.pubkey(keypath)
+	if err != nil {
+		return "", err
+	}
+	pkScript, err := simplePkScript(simpleType, pubkey)
+	if err != nil {
+		return "", err
+	}
+	return encodeAddress(coin, pkScript)
+}
+
+// btcDeriveAddressSimple derives the address of a single-sig account at the given address-level
+// keypath from the account xpub, without a device. It is meant to cross-check the addresses
+// displayed by the device.
+func btcDeriveAddressSimple(
+	coin messages.BTCCoin,
+	simpleType messages.BTCScriptConfig_SimpleType,
+	xpub string,
+	keypathAccount []uint32,
+	keypath []uint32,
+) (string, *jsError) {
+	if simpleType == messages.BTCScriptConfig_P2TR {
+		if err := validateBIP86Keypath(coin, keypath, true); err != nil {
+			return "", toJSError(err)
+		}
+	}
+	parsedXPub, err := firmware.NewXPub(xpub)
+	if err != nil {
+		return "", toJSError(err)
+	}
+	address, err := simpleAddress(
+		coin, simpleType, &accountXPub{keypath: keypathAccount, xpub: parsedXPub}, keypath)
+	return address, toJSError(err)
+}
+
+// btcDeriveAddressMultisig derives the address of a multisig account at the given address-level
+// keypath from the xpubs of the cosigners, without a device. It is meant to cross-check the
+// addresses displayed by the device.
+func btcDeriveAddressMultisig(config *btcMultisigConfig, keypath []uint32) (string, *jsError) {
+	account, err := config.account()
+	if err != nil {
+		return "", toJSError(err)
+	}
+	pkScript, err := account.pkScript(keypath)
+	if err != nil {
+		return "", toJSError(err)
+	}
+	address, err := encodeAddress(config.Coin, pkScript)
+	return address, toJSError(err)
+}
@@ -0,0 +1,197 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+func TestEncodeDecodeAddress(t *testing.T) {
+	tests := []struct {
+		coin       messages.BTCCoin
+		pkScript   string
+		address    string
+		outputType messages.BTCOutputType
+	}{
+		{
+			messages.BTCCoin_BTC,
+			"76a914751e76e8199196d454941c45d1b3a323f1433bd688ac",
+			"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+			messages.BTCOutputType_P2PKH,
+		},
+		// BIP49 test vector.
+		{
+			messages.BTCCoin_TBTC,
+			"a914336caa13e08b96080a32b5d818d59b4ab3b3674287",
+			"2Mww8dCYPUpKHofjgcXcBCEGmniw9CoaiD2",
+			messages.BTCOutputType_P2SH,
+		},
+		// BIP173 test vectors.
+		{
+			messages.BTCCoin_BTC,
+			"0014751e76e8199196d454941c45d1b3a323f1433bd6",
+			"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			messages.BTCOutputType_P2WPKH,
+		},
+		{
+			messages.BTCCoin_TBTC,
+			"00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262",
+			"tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7",
+			messages.BTCOutputType_P2WSH,
+		},
+		// BIP350 test vector.
+		{
+			messages.BTCCoin_TBTC,
+			"5120000000c4a5cad46221b2a187905e5266362b99d5e91c6ce24d165dab93e86433",
+			"tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c",
+			messages.BTCOutputType_P2TR,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.address, func(t *testing.T) {
+			pkScript := unhex(t, test.pkScript)
+			address, err := encodeAddress(test.coin, pkScript)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if address != test.address {
+				t.Errorf("got address %s, expected %s", address, test.address)
+			}
+			outputType, payload, err := decodeAddress(test.coin, test.address)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if outputType != test.outputType {
+				t.Errorf("got output type %s, expected %s", outputType, test.outputType)
+			}
+			decoded, err := outputPkScript(outputType, payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(decoded, pkScript) {
+				t.Errorf("got pkScript %x, expected %x", decoded, pkScript)
+			}
+		})
+	}
+}
+
+func TestDecodeAddressErrors(t *testing.T) {
+	// Segwit v0 program encoded with the bech32m checksum, which is invalid as per BIP350.
+	data, err := bech32.ConvertBits(unhex(t, "751e76e8199196d454941c45d1b3a323f1433bd6"), 8, 5, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v0Bech32m, err := bech32.EncodeM("bc", append([]byte{0}, data...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		coin    messages.BTCCoin
+		address string
+	}{
+		{"testnet address", messages.BTCCoin_BTC, "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7"},
+		{"mainnet address", messages.BTCCoin_TBTC, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+		{"litecoin address", messages.BTCCoin_BTC, "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9"},
+		{"invalid bech32 checksum", messages.BTCCoin_BTC, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5"},
+		{"invalid base58 checksum", messages.BTCCoin_BTC, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ"},
+		{"bech32m segwit v0", messages.BTCCoin_BTC, v0Bech32m},
+		{"unsupported coin", messages.BTCCoin(100), "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, _, err := decodeAddress(test.coin, test.address); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestDeriveAddressSimple(t *testing.T) {
+	tests := []struct {
+		name       string
+		coin       messages.BTCCoin
+		simpleType messages.BTCScriptConfig_SimpleType
+		xpub       string
+		keypath    []uint32
+		address    string
+	}{
+		{
+			name:       "BIP84",
+			coin:       messages.BTCCoin_BTC,
+			simpleType: messages.BTCScriptConfig_P2WPKH,
+			xpub:       "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs",
+			keypath:    []uint32{84 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart},
+			address:    "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu",
+		},
+		{
+			name:       "BIP86",
+			coin:       messages.BTCCoin_BTC,
+			simpleType: messages.BTCScriptConfig_P2TR,
+			xpub:       "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ",
+			keypath:    []uint32{86 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart},
+			address:    "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr",
+		},
+		{
+			name:       "BIP49",
+			coin:       messages.BTCCoin_TBTC,
+			simpleType: messages.BTCScriptConfig_P2WPKH_P2SH,
+			xpub:       "tpubDD7tXK8KeQ3YY83yWq755fHY2JW8Ha8Q765tknUM5rSvjPcGWfUppDFMpQ1ScziKfW3ZNtZvAD7M3u7bSs7HofjTD3KP3YxPK7X6hwV8Rk2",
+			keypath:    []uint32{49 + hardenedKeyStart, 1 + hardenedKeyStart, 0 + hardenedKeyStart},
+			address:    "2Mww8dCYPUpKHofjgcXcBCEGmniw9CoaiD2",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// First receive address.
+			address, err := btcDeriveAddressSimple(
+				test.coin, test.simpleType, test.xpub, test.keypath,
+				append(append([]uint32{}, test.keypath...), 0, 0))
+			if err != nil {
+				t.Fatal(err.Message)
+			}
+			if address != test.address {
+				t.Errorf("got address %s, expected %s", address, test.address)
+			}
+		})
+	}
+}
+
+func TestDeriveAddressSimpleErrors(t *testing.T) {
+	xpub := "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ"
+	keypathAccount := []uint32{86 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart}
+	tests := []struct {
+		name       string
+		simpleType messages.BTCScriptConfig_SimpleType
+		xpub       string
+		keypath    []uint32
+	}{
+		{"invalid xpub", messages.BTCScriptConfig_P2TR, "xpub123", append(keypathAccount[:3:3], 0, 0)},
+		{"hardened address", messages.BTCScriptConfig_P2TR, xpub, append(keypathAccount[:3:3], 0, hardenedKeyStart)},
+		{"other account", messages.BTCScriptConfig_P2WPKH, xpub, []uint32{86 + hardenedKeyStart, 0 + hardenedKeyStart, 1 + hardenedKeyStart, 0, 0}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := btcDeriveAddressSimple(
+				messages.BTCCoin_BTC, test.simpleType, test.xpub, keypathAccount, test.keypath); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
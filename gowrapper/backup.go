@@ -0,0 +1,64 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// AsyncCreateBackup creates a backup on the inserted microSD card. If the device is in
+// StatusSeeded, the status changes to StatusInitialized on success.
+func (device *jsDevice) AsyncCreateBackup(done func(*jsError)) {
+	go func() {
+		done(toJSError(device.device.CreateBackup()))
+	}()
+}
+
+// AsyncListBackups lists the backups on the inserted microSD card. Each backup is returned as an
+// object with the keys "id", "name" and "timestamp" (unix seconds).
+func (device *jsDevice) AsyncListBackups(done func([]map[string]interface{}, *jsError)) {
+	go func() {
+		backups, err := device.device.ListBackups()
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		result := make([]map[string]interface{}, len(backups))
+		for i, backup := range backups {
+			result[i] = map[string]interface{}{
+				"id":        backup.ID,
+				"name":      backup.Name,
+				"timestamp": backup.Time.Unix(),
+			}
+		}
+		done(result, nil)
+	}()
+}
+
+// AsyncCheckBackup checks if the inserted microSD card contains a backup matching the device
+// seed, and returns the ID of the backup. If silent is false, the result is also shown on the
+// device.
+func (device *jsDevice) AsyncCheckBackup(done func(string, *jsError), silent bool) {
+	go func() {
+		id, err := device.device.CheckBackup(silent)
+		done(id, toJSError(err))
+	}()
+}
+
+// AsyncRestoreBackup restores the backup with the given ID from the inserted microSD card. On
+// success, the status changes to StatusInitialized.
+func (device *jsDevice) AsyncRestoreBackup(done func(*jsError), id string) {
+	go func() {
+		done(toJSError(device.withSeedChange(func() error {
+			return device.device.RestoreBackup(id)
+		})))
+	}()
+}
@@ -0,0 +1,300 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/common"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/util/errp"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+const bootloaderCMD = 0x80 + 0x40 + 0x03
+
+const (
+	// chunkSize is the size of a firmware chunk written with one write command.
+	chunkSize = 4096
+	// maxFirmwareSize is the size of the firmware flash area.
+	maxFirmwareSize = 884736
+
+	magicLen        = 4
+	versionLen      = 4
+	pubkeyLen       = 64
+	signatureLen    = 64
+	numRootKeys     = 3
+	numSigningKeys  = 3
+	signingKeysLen  = versionLen + numSigningKeys*pubkeyLen + numRootKeys*signatureLen
+	firmwareDataLen = versionLen + numSigningKeys*signatureLen
+	sigDataLen      = signingKeysLen + firmwareDataLen
+)
+
+// sigDataMagic is the magic number prefixing a signed firmware binary, identifying the product the
+// firmware is built for.
+var sigDataMagic = map[common.Product]uint32{
+	common.ProductBitBox02Multi:   0x653f362b,
+	common.ProductBitBox02BTCOnly: 0x11233b0b,
+}
+
+// parseSignedFirmware checks the magic number of a signed firmware binary and splits it into the
+// signature data and the firmware.
+func parseSignedFirmware(product common.Product, signedFirmware []byte) ([]byte, []byte, error) {
+	if len(signedFirmware) <= magicLen+sigDataLen {
+		return nil, nil, errors.New("firmware too small")
+	}
+	magic, signedFirmware := signedFirmware[:magicLen], signedFirmware[magicLen:]
+	expectedMagic, ok := sigDataMagic[product]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported product: %s", product)
+	}
+	if binary.BigEndian.Uint32(magic) != expectedMagic {
+		return nil, nil, errors.New("firmware binary does not match the device edition")
+	}
+	sigData, unsignedFirmware := signedFirmware[:sigDataLen], signedFirmware[sigDataLen:]
+	if len(unsignedFirmware) > maxFirmwareSize {
+		return nil, nil, errors.New("firmware too big")
+	}
+	return sigData, unsignedFirmware, nil
+}
+
+// jsBootloader is the bootloader client exposed to JavaScript. The Async methods follow the same
+// pattern as the ones of jsDevice.
+type jsBootloader struct {
+	*jsConnection
+	communication firmware.Communication
+	product       common.Product
+}
+
+func newJSBootloader(
+	productString string, communication firmware.Communication, connection *jsConnection,
+) *js.Object {
+	product, err := common.ProductFromHIDProductString(productString)
+	if err != nil {
+		// Thrown as a regular exception in JavaScript.
+		panic(js.Global.Get("Error").New(err.Error()))
+	}
+	return js.MakeWrapper(&jsBootloader{
+		jsConnection:  connection,
+		communication: communication,
+		product:       product,
+	})
+}
+
+// newJSBootloaderBridge creates a bootloader client communicating through the BitBoxBridge.
+// productString is the USB HID product string of the device, e.g. "bb02-bootloader".
+func newJSBootloaderBridge(productString string, onWrite func([]byte)) *js.Object {
+	connection := newJSConnection()
+	return newJSBootloader(
+		productString, connection.bridgeCommunication(onWrite, bootloaderCMD), connection)
+}
+
+// newJSBootloaderWebHID creates a bootloader client communicating through WebHID.
+// productString is the USB HID product string of the device, e.g. "bb02-bootloader".
+func newJSBootloaderWebHID(productString string, onWrite func([]byte)) *js.Object {
+	connection := newJSConnection()
+	return newJSBootloader(
+		productString, connection.webHIDCommunication(onWrite, bootloaderCMD), connection)
+}
+
+// query sends a bootloader command and returns the response payload. Each response starts with
+// the command byte, followed by a status byte, which is zero on success.
+func (bootloader *jsBootloader) query(cmd byte, data []byte) ([]byte, error) {
+	response, err := bootloader.communication.Query(append([]byte{cmd}, data...))
+	if err != nil {
+		return nil, err
+	}
+	if len(response) < 2 {
+		return nil, errors.New("unexpected response")
+	}
+	if response[0] != cmd {
+		return nil, fmt.Errorf("unexpected response: expected %q, got %q", cmd, response[0])
+	}
+	if response[1] != 0 {
+		return nil, fmt.Errorf("bootloader command %q failed with status %d", cmd, response[1])
+	}
+	return response[2:], nil
+}
+
+func (bootloader *jsBootloader) erase(numChunks uint8) error {
+	_, err := bootloader.query('e', []byte{numChunks})
+	return err
+}
+
+func (bootloader *jsBootloader) writeChunk(chunkNum uint8, chunk []byte) error {
+	if len(chunk) > chunkSize {
+		return errors.New("chunk too large")
+	}
+	var buf bytes.Buffer
+	buf.WriteByte(chunkNum)
+	buf.Write(chunk)
+	// The last chunk is padded to the full chunk size with the erased flash value.
+	buf.Write(bytes.Repeat([]byte{0xFF}, chunkSize-len(chunk)))
+	_, err := bootloader.query('w', buf.Bytes())
+	return err
+}
+
+// reboot reboots the device. Once the firmware has been written, the bootloader verifies the
+// signatures before starting it.
+func (bootloader *jsBootloader) reboot() error {
+	_, err := bootloader.query('r', nil)
+	// The device may reboot before it responds. The error is wrapped by the WebHID communication.
+	if errp.Cause(err) == errConnectionClosed {
+		return nil
+	}
+	return err
+}
+
+// flashSignedFirmware erases the firmware area, writes the firmware in chunks and sends the
+// signature data, which the bootloader verifies against the written firmware.
+func (bootloader *jsBootloader) flashSignedFirmware(
+	signedFirmware []byte, onProgress func(float64)) error {
+	sigData, unsignedFirmware, err := parseSignedFirmware(bootloader.product, signedFirmware)
+	if err != nil {
+		return err
+	}
+	numChunks := (len(unsignedFirmware) + chunkSize - 1) / chunkSize
+	if err := bootloader.erase(uint8(numChunks)); err != nil {
+		return err
+	}
+	for chunkNum := 0; chunkNum < numChunks; chunkNum++ {
+		end := (chunkNum + 1) * chunkSize
+		if end > len(unsignedFirmware) {
+			end = len(unsignedFirmware)
+		}
+		if err := bootloader.writeChunk(
+			uint8(chunkNum), unsignedFirmware[chunkNum*chunkSize:end]); err != nil {
+			return err
+		}
+		onProgress(float64(chunkNum+1) / float64(numChunks))
+	}
+	_, err = bootloader.query('s', sigData)
+	return err
+}
+
+// Product returns the product identified by the USB HID product string.
+func (bootloader *jsBootloader) Product() common.Product {
+	return bootloader.product
+}
+
+// AsyncVersions returns the version of the installed firmware and of the firmware signing keys,
+// as an object with the keys "firmwareVersion" and "signingPubkeysVersion". The versions are
+// monotonic counters, not semantic versions.
+func (bootloader *jsBootloader) AsyncVersions(done func(map[string]interface{}, *jsError)) {
+	go func() {
+		response, err := bootloader.query('v', nil)
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		if len(response) < 8 {
+			done(nil, toJSError(errors.New("unexpected response")))
+			return
+		}
+		done(map[string]interface{}{
+			"firmwareVersion":       binary.LittleEndian.Uint32(response[:4]),
+			"signingPubkeysVersion": binary.LittleEndian.Uint32(response[4:8]),
+		}, nil)
+	}()
+}
+
+// AsyncGetHashes returns the hashes of the installed firmware and of the signing key data, as an
+// object with the keys "firmwareHash" and "signingKeydataHash". If the respective display flag is
+// true, the hash is also shown on the device, so the user can compare it.
+func (bootloader *jsBootloader) AsyncGetHashes(
+	done func(map[string]interface{}, *jsError),
+	displayFirmwareHash bool,
+	displaySigningKeydataHash bool,
+) {
+	go func() {
+		payload := []byte{0, 0}
+		if displayFirmwareHash {
+			payload[0] = 1
+		}
+		if displaySigningKeydataHash {
+			payload[1] = 1
+		}
+		response, err := bootloader.query('h', payload)
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		if len(response) < 64 {
+			done(nil, toJSError(errors.New("unexpected response")))
+			return
+		}
+		done(map[string]interface{}{
+			"firmwareHash":       response[:32],
+			"signingKeydataHash": response[32:64],
+		}, nil)
+	}()
+}
+
+// AsyncShowFirmwareHashEnabled returns true if the bootloader shows the firmware hash on every
+// boot.
+func (bootloader *jsBootloader) AsyncShowFirmwareHashEnabled(done func(bool, *jsError)) {
+	go func() {
+		// 0xFF queries the setting without changing it.
+		response, err := bootloader.query('H', []byte{0xFF})
+		if err != nil {
+			done(false, toJSError(err))
+			return
+		}
+		if len(response) < 1 {
+			done(false, toJSError(errors.New("unexpected response")))
+			return
+		}
+		done(response[0] != 0, nil)
+	}()
+}
+
+// AsyncSetShowFirmwareHashEnabled enables or disables showing the firmware hash on every boot.
+func (bootloader *jsBootloader) AsyncSetShowFirmwareHashEnabled(done func(*jsError), enabled bool) {
+	go func() {
+		enabledByte := byte(0)
+		if enabled {
+			enabledByte = 1
+		}
+		_, err := bootloader.query('H', []byte{enabledByte})
+		done(toJSError(err))
+	}()
+}
+
+// AsyncUpgradeFirmware flashes a signed firmware binary and reboots into the new firmware.
+// onProgress is called with the progress between 0 and 1 after each written chunk.
+func (bootloader *jsBootloader) AsyncUpgradeFirmware(
+	done func(*jsError),
+	signedFirmware []byte,
+	onProgress func(float64),
+) {
+	go func() {
+		if err := bootloader.flashSignedFirmware(signedFirmware, onProgress); err != nil {
+			done(toJSError(err))
+			return
+		}
+		done(toJSError(bootloader.reboot()))
+	}()
+}
+
+// AsyncReboot reboots the device. The bootloader only starts the firmware if its signatures are
+// valid.
+func (bootloader *jsBootloader) AsyncReboot(done func(*jsError)) {
+	go func() {
+		done(toJSError(bootloader.reboot()))
+	}()
+}
@@ -0,0 +1,196 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/common"
+)
+
+// signedTestFirmware returns a signed firmware binary with the given magic number and firmware
+// size. The signature data is filled with 0x01 and the firmware with 0x02.
+func signedTestFirmware(magic uint32, firmwareSize int) []byte {
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.BigEndian, magic)
+	buf.Write(bytes.Repeat([]byte{0x01}, sigDataLen))
+	buf.Write(bytes.Repeat([]byte{0x02}, firmwareSize))
+	return buf.Bytes()
+}
+
+func TestParseSignedFirmware(t *testing.T) {
+	multiMagic := sigDataMagic[common.ProductBitBox02Multi]
+	tests := []struct {
+		name           string
+		product        common.Product
+		signedFirmware []byte
+		err            string
+	}{
+		{"valid", common.ProductBitBox02Multi, signedTestFirmware(multiMagic, 100), ""},
+		{
+			"valid btc-only",
+			common.ProductBitBox02BTCOnly,
+			signedTestFirmware(sigDataMagic[common.ProductBitBox02BTCOnly], 100),
+			"",
+		},
+		{
+			"maximum size",
+			common.ProductBitBox02Multi,
+			signedTestFirmware(multiMagic, maxFirmwareSize),
+			"",
+		},
+		{
+			"wrong magic",
+			common.ProductBitBox02BTCOnly,
+			signedTestFirmware(multiMagic, 100),
+			"firmware binary does not match the device edition",
+		},
+		{
+			"unsupported product",
+			common.ProductBitBoxBaseStandard,
+			signedTestFirmware(multiMagic, 100),
+			"unsupported product: bitboxbase-standard",
+		},
+		{"empty", common.ProductBitBox02Multi, nil, "firmware too small"},
+		{
+			"truncated header",
+			common.ProductBitBox02Multi,
+			signedTestFirmware(multiMagic, 100)[:magicLen+sigDataLen-1],
+			"firmware too small",
+		},
+		{
+			"header without firmware",
+			common.ProductBitBox02Multi,
+			signedTestFirmware(multiMagic, 0),
+			"firmware too small",
+		},
+		{
+			"too big",
+			common.ProductBitBox02Multi,
+			signedTestFirmware(multiMagic, maxFirmwareSize+1),
+			"firmware too big",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sigData, unsignedFirmware, err := parseSignedFirmware(test.product, test.signedFirmware)
+			if test.err != "" {
+				if err == nil || err.Error() != test.err {
+					t.Fatalf("got error %v, expected %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(sigData, test.signedFirmware[magicLen:magicLen+sigDataLen]) {
+				t.Error("unexpected signature data")
+			}
+			if !bytes.Equal(unsignedFirmware, test.signedFirmware[magicLen+sigDataLen:]) {
+				t.Error("unexpected firmware")
+			}
+		})
+	}
+}
+
+// testBootloaderCommunication records the bootloader commands and responds with success.
+type testBootloaderCommunication struct {
+	queries [][]byte
+}
+
+func (communication *testBootloaderCommunication) Query(msg []byte) ([]byte, error) {
+	communication.queries = append(communication.queries, msg)
+	return []byte{msg[0], 0}, nil
+}
+
+func (communication *testBootloaderCommunication) Close() {}
+
+func TestFlashSignedFirmware(t *testing.T) {
+	tests := []struct {
+		name         string
+		firmwareSize int
+		numChunks    int
+		lastChunkLen int
+	}{
+		{"one byte", 1, 1, 1},
+		{"exact multiple", 3 * chunkSize, 3, chunkSize},
+		{"non-multiple", 2*chunkSize + 100, 3, 100},
+		{"maximum size", maxFirmwareSize, maxFirmwareSize / chunkSize, chunkSize},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			communication := &testBootloaderCommunication{}
+			bootloader := &jsBootloader{
+				communication: communication,
+				product:       common.ProductBitBox02Multi,
+			}
+			signedFirmware := signedTestFirmware(
+				sigDataMagic[common.ProductBitBox02Multi], test.firmwareSize)
+			var progress []float64
+			if err := bootloader.flashSignedFirmware(signedFirmware, func(p float64) {
+				progress = append(progress, p)
+			}); err != nil {
+				t.Fatal(err)
+			}
+			queries := communication.queries
+			// Erase, one write per chunk, signature data.
+			if len(queries) != test.numChunks+2 {
+				t.Fatalf("got %d queries, expected %d", len(queries), test.numChunks+2)
+			}
+			if !bytes.Equal(queries[0], []byte{'e', byte(test.numChunks)}) {
+				t.Errorf("unexpected erase command %x", queries[0])
+			}
+			for chunkNum, query := range queries[1 : len(queries)-1] {
+				if len(query) != 2+chunkSize || query[0] != 'w' || query[1] != byte(chunkNum) {
+					t.Fatalf("unexpected write command %x", query[:2])
+				}
+				chunkLen := chunkSize
+				if chunkNum == test.numChunks-1 {
+					chunkLen = test.lastChunkLen
+				}
+				chunk := query[2:]
+				if !bytes.Equal(chunk[:chunkLen], bytes.Repeat([]byte{0x02}, chunkLen)) {
+					t.Errorf("chunk %d: unexpected firmware data", chunkNum)
+				}
+				// Padded with the erased flash value.
+				if !bytes.Equal(chunk[chunkLen:], bytes.Repeat([]byte{0xFF}, chunkSize-chunkLen)) {
+					t.Errorf("chunk %d: unexpected padding", chunkNum)
+				}
+			}
+			signCommand := queries[len(queries)-1]
+			if signCommand[0] != 's' ||
+				!bytes.Equal(signCommand[1:], bytes.Repeat([]byte{0x01}, sigDataLen)) {
+				t.Error("unexpected signature data command")
+			}
+			if len(progress) != test.numChunks || progress[len(progress)-1] != 1 {
+				t.Errorf("unexpected progress %v", progress)
+			}
+		})
+	}
+}
+
+func TestWriteChunkTooLarge(t *testing.T) {
+	communication := &testBootloaderCommunication{}
+	bootloader := &jsBootloader{communication: communication, product: common.ProductBitBox02Multi}
+	if err := bootloader.writeChunk(0, make([]byte, chunkSize+1)); err == nil ||
+		err.Error() != "chunk too large" {
+		t.Errorf("got error %v, expected %q", err, "chunk too large")
+	}
+	if len(communication.queries) != 0 {
+		t.Error("oversized chunk was sent to the device")
+	}
+}
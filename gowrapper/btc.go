@@ -0,0 +1,233 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+	"github.com/digitalbitbox/bitbox02-api-go/util/semver"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+type btcMultisig struct {
+	*js.Object
+	Threshold    uint32                                       `js:"threshold"`
+	XPubs        []string                                     `js:"xpubs"`
+	OurXPubIndex uint32                                       `js:"ourXPubIndex"`
+	ScriptType   messages.BTCScriptConfig_Multisig_ScriptType `js:"scriptType"`
+}
+
+// newBTCScriptConfigMultisig is like firmware.NewBTCScriptConfigMultisig, but also sets the script
+// type, so that wrapped segwit (P2WSH-P2SH) multisig accounts can be used.
+func newBTCScriptConfigMultisig(
+	threshold uint32,
+	xpubs []string,
+	ourXPubIndex uint32,
+	scriptType messages.BTCScriptConfig_Multisig_ScriptType,
+) (*messages.BTCScriptConfig, error) {
+	if _, ok := messages.BTCScriptConfig_Multisig_ScriptType_name[int32(scriptType)]; !ok {
+		return nil, errors.New("invalid multisig script type")
+	}
+	scriptConfig, err := firmware.NewBTCScriptConfigMultisig(threshold, xpubs, ourXPubIndex)
+	if err != nil {
+		return nil, err
+	}
+	scriptConfig.GetMultisig().ScriptType = scriptType
+	return scriptConfig, nil
+}
+
+type btcScriptConfig struct {
+	*js.Object
+	// Poor man's union: one of the fields must be set.
+	SimpleType *js.Object   `js:"simpleType"`
+	Multisig   *btcMultisig `js:"multisig"`
+	Policy     *btcPolicy   `js:"policy"`
+}
+
+func (config *btcScriptConfig) toScriptConfig() (*messages.BTCScriptConfig, error) {
+	var result *messages.BTCScriptConfig
+	count := 0
+	if config.SimpleType != js.Undefined {
+		count += 1
+		result = firmware.NewBTCScriptConfigSimple(
+			messages.BTCScriptConfig_SimpleType(config.SimpleType.Int()))
+	}
+	if config.Multisig.Object != js.Undefined {
+		count += 1
+		var err error
+		result, err = newBTCScriptConfigMultisig(
+			config.Multisig.Threshold,
+			config.Multisig.XPubs,
+			config.Multisig.OurXPubIndex,
+			config.Multisig.ScriptType,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if config.Policy.Object != js.Undefined {
+		count += 1
+		var err error
+		result, err = config.Policy.toScriptConfig()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if count != 1 {
+		return nil, errors.New("one of simpleType, multisig, policy must be set")
+	}
+	return result, nil
+}
+
+type btcScriptConfigWithKeypath struct {
+	*js.Object
+	ScriptConfig *btcScriptConfig `js:"scriptConfig"`
+	Keypath      []uint32         `js:"keypath"`
+}
+
+func convertScriptConfigs(
+	scriptConfigs []*btcScriptConfigWithKeypath,
+) ([]*messages.BTCScriptConfigWithKeypath, error) {
+	result := make([]*messages.BTCScriptConfigWithKeypath, len(scriptConfigs))
+	for i, scriptConfig := range scriptConfigs {
+		conf, err := scriptConfig.ScriptConfig.toScriptConfig()
+		if err != nil {
+			return nil, err
+		}
+		result[i] = &messages.BTCScriptConfigWithKeypath{
+			ScriptConfig: conf,
+			Keypath:      scriptConfig.Keypath,
+		}
+	}
+	return result, nil
+}
+
+// checkScriptConfigSupported checks that the connected device supports the script config.
+func (device *jsDevice) checkScriptConfigSupported(scriptConfig *messages.BTCScriptConfig) error {
+	switch scriptConfig.Config.(type) {
+	case *messages.BTCScriptConfig_SimpleType_:
+		if scriptConfig.GetSimpleType() == messages.BTCScriptConfig_P2TR {
+			return device.checkTaprootSupported()
+		}
+	case *messages.BTCScriptConfig_Multisig_:
+		if scriptConfig.GetMultisig().ScriptType == messages.BTCScriptConfig_Multisig_P2WSH_P2SH &&
+			!device.device.Version().AtLeast(semver.NewSemVer(9, 1, 0)) {
+			return firmware.UnsupportedError("9.1.0")
+		}
+	case *messages.BTCScriptConfig_Policy_:
+		return device.checkPolicySupported(scriptConfig.GetPolicy().Policy)
+	}
+	return nil
+}
+
+// checkSign checks that the connected device supports the script configs of a transaction, and
+// that the keypaths of its taproot inputs and change outputs are valid (see checkTaprootKeypaths).
+func (device *jsDevice) checkSign(
+	coin messages.BTCCoin,
+	scriptConfigs []*messages.BTCScriptConfigWithKeypath,
+	tx *firmware.BTCTx,
+) error {
+	for _, scriptConfig := range scriptConfigs {
+		if err := device.checkScriptConfigSupported(scriptConfig.ScriptConfig); err != nil {
+			return err
+		}
+	}
+	return checkTaprootKeypaths(coin, scriptConfigs, tx)
+}
+
+// AsyncBTCSign signs a transaction spending from and sending to any number of accounts. Each input
+// and each change output references its account in scriptConfigs by its scriptConfigIndex. The
+// signatures are verified before they are returned, unless the transaction involves a policy
+// account (see involvesPolicy).
+func (device *jsDevice) AsyncBTCSign(
+	done func([][]byte, *jsError),
+	coin messages.BTCCoin,
+	scriptConfigs []*btcScriptConfigWithKeypath,
+	inputs []*btcSignInputRequest,
+	outputs []*btcSignOutputRequest,
+	version uint32,
+	locktime uint32,
+) {
+	go func() {
+		theScriptConfigs, err := convertScriptConfigs(scriptConfigs)
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		theInputs, theOutputs, err := convertInputsAndOutputs(coin, inputs, outputs)
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		for _, input := range theInputs {
+			if int(input.Input.ScriptConfigIndex) >= len(theScriptConfigs) {
+				done(nil, toJSError(errors.New("input scriptConfigIndex out of range")))
+				return
+			}
+		}
+		for _, output := range theOutputs {
+			if output.Ours && int(output.ScriptConfigIndex) >= len(theScriptConfigs) {
+				done(nil, toJSError(errors.New("output scriptConfigIndex out of range")))
+				return
+			}
+		}
+		tx := &firmware.BTCTx{
+			Version:  version,
+			Inputs:   theInputs,
+			Outputs:  theOutputs,
+			Locktime: locktime,
+		}
+		if err := device.checkSign(coin, theScriptConfigs, tx); err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		signatures, err := device.device.BTCSign(
+			coin, theScriptConfigs, tx, messages.BTCSignInitRequest_DEFAULT)
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		// The signatures of a transaction involving a policy account are returned unverified, like
+		// in AsyncBTCSignPolicy.
+		if !involvesPolicy(theScriptConfigs, tx) {
+			if err := device.verifySignatures(coin, theScriptConfigs, tx, signatures); err != nil {
+				done(nil, toJSError(err))
+				return
+			}
+		}
+		done(signatures, nil)
+	}()
+}
+
+// derSignature converts a 64 byte compact signature (r, s) to DER, as used in Bitcoin scripts
+// (without the sighash byte).
+func derSignature(signature []byte) []byte {
+	encodeInt := func(b []byte) []byte {
+		for len(b) > 1 && b[0] == 0 {
+			b = b[1:]
+		}
+		if b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return append([]byte{0x02, byte(len(b))}, b...)
+	}
+	r := encodeInt(signature[:32])
+	s := encodeInt(signature[32:])
+	der := []byte{0x30, byte(len(r) + len(s))}
+	der = append(der, r...)
+	return append(der, s...)
+}
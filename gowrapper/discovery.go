@@ -0,0 +1,247 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// bip44Purposes are the purposes of the single-sig account keypaths,
+// m/<purpose>'/<coin>'/<account>'.
+var bip44Purposes = map[messages.BTCScriptConfig_SimpleType]uint32{
+	messages.BTCScriptConfig_P2WPKH_P2SH: 49 + hardenedKeyStart,
+	messages.BTCScriptConfig_P2WPKH:      84 + hardenedKeyStart,
+	messages.BTCScriptConfig_P2TR:        bip86Purpose,
+}
+
+const (
+	// maxDiscoveryAddresses is the number of addresses per chain after which discovery fails, so that
+	// an isUsed reporting every address as used can't make it scan endlessly.
+	maxDiscoveryAddresses = 10000
+	// maxDiscoveryAccounts is the highest number of accounts that can be discovered. Each account
+	// costs a device round trip for its xpub, and the firmware rejects higher account indexes.
+	maxDiscoveryAccounts = 100
+)
+
+type btcDiscoverAccountsOptions struct {
+	*js.Object
+	GapLimit    uint32 `js:"gapLimit"`
+	MaxAccounts uint32 `js:"maxAccounts"`
+}
+
+// addressesUsed calls the JS function isUsed(addresses), which returns a promise, and waits for the
+// result.
+func addressesUsed(isUsed *js.Object, addresses []string) (interface{}, error) {
+	resultChan := make(chan *js.Object, 1)
+	errChan := make(chan error, 1)
+	isUsed.Invoke(addresses).Call("then",
+		func(used *js.Object) { resultChan <- used },
+		func(err *js.Object) { errChan <- errors.New(err.String()) },
+	)
+	select {
+	case result := <-resultChan:
+		return result.Interface(), nil
+	case err := <-errChan:
+		return nil, err
+	}
+}
+
+// parseUsed converts the result of isUsed, which must be an array of one boolean per address.
+func parseUsed(result interface{}, numAddresses int) ([]bool, error) {
+	errInvalid := fmt.Errorf("isUsed must return an array of %d booleans", numAddresses)
+	array, ok := result.([]interface{})
+	if !ok || len(array) != numAddresses {
+		return nil, errInvalid
+	}
+	used := make([]bool, numAddresses)
+	for i, element := range array {
+		addressUsed, ok := element.(bool)
+		if !ok {
+			return nil, errInvalid
+		}
+		used[i] = addressUsed
+	}
+	return used, nil
+}
+
+// scanChain scans the receive (chain 0) or change (chain 1) addresses of an account until gapLimit
+// consecutive addresses are unused. isUsed is called with each batch of addresses (see
+// addressesUsed). It returns the used addresses and the index following the last used address.
+// Scanning fails if it would go beyond maxDiscoveryAddresses addresses.
+func scanChain(
+	coin messages.BTCCoin,
+	simpleType messages.BTCScriptConfig_SimpleType,
+	account *accountXPub,
+	chain uint32,
+	gapLimit uint32,
+	isUsed func(addresses []string) (interface{}, error),
+) ([]interface{}, uint32, error) {
+	usedAddresses := []interface{}{}
+	nextIndex := uint32(0)
+	for index := uint32(0); index < nextIndex+gapLimit; {
+		// Both are at most maxDiscoveryAddresses, so the sum does not overflow.
+		if nextIndex+gapLimit > maxDiscoveryAddresses {
+			return nil, 0, fmt.Errorf(
+				"more than %d addresses of chain %d scanned", maxDiscoveryAddresses, chain)
+		}
+		var keypaths [][]uint32
+		var addresses []string
+		for ; index < nextIndex+gapLimit; index++ {
+			keypath := append(append([]uint32{}, account.keypath...), chain, index)
+			address, err := simpleAddress(coin, simpleType, account, keypath)
+			if err != nil {
+				return nil, 0, err
+			}
+			keypaths = append(keypaths, keypath)
+			addresses = append(addresses, address)
+		}
+		result, err := isUsed(addresses)
+		if err != nil {
+			return nil, 0, err
+		}
+		used, err := parseUsed(result, len(addresses))
+		if err != nil {
+			return nil, 0, err
+		}
+		for i, addressUsed := range used {
+			if !addressUsed {
+				continue
+			}
+			usedAddresses = append(usedAddresses, map[string]interface{}{
+				"keypath": jsKeypath(keypaths[i]),
+				"address": addresses[i],
+			})
+			nextIndex = keypaths[i][len(keypaths[i])-1] + 1
+		}
+	}
+	return usedAddresses, nextIndex, nil
+}
+
+// discoverAccounts scans the accounts 0, 1, ... below keypathPrefix (m/<purpose>'/<coin>') for used
+// addresses, until an account has no used receive addresses or maxAccounts accounts were scanned.
+// xpub returns the xpub of an account given its keypath, and isUsed is as in scanChain.
+func discoverAccounts(
+	coin messages.BTCCoin,
+	simpleType messages.BTCScriptConfig_SimpleType,
+	keypathPrefix []uint32,
+	gapLimit uint32,
+	maxAccounts uint32,
+	xpub func(keypathAccount []uint32) (string, error),
+	isUsed func(addresses []string) (interface{}, error),
+) ([]interface{}, error) {
+	accounts := []interface{}{}
+	for accountIndex := uint32(0); accountIndex < maxAccounts; accountIndex++ {
+		keypathAccount := append(
+			append([]uint32{}, keypathPrefix...), accountIndex+hardenedKeyStart)
+		xpubStr, err := xpub(keypathAccount)
+		if err != nil {
+			return nil, err
+		}
+		convertedXPub, err := firmware.NewXPub(xpubStr)
+		if err != nil {
+			return nil, err
+		}
+		account := &accountXPub{keypath: keypathAccount, xpub: convertedXPub}
+		receiveAddresses, receiveIndex, err := scanChain(
+			coin, simpleType, account, 0, gapLimit, isUsed)
+		if err != nil {
+			return nil, err
+		}
+		// As per BIP44, an account is used if its external chain is. Change addresses can only
+		// have been used if receive addresses were.
+		if len(receiveAddresses) == 0 {
+			break
+		}
+		changeAddresses, changeIndex, err := scanChain(
+			coin, simpleType, account, 1, gapLimit, isUsed)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, map[string]interface{}{
+			"account":        accountIndex,
+			"keypathAccount": jsKeypath(keypathAccount),
+			"xpub":           xpubStr,
+			"receive": map[string]interface{}{
+				"usedAddresses": receiveAddresses,
+				"nextIndex":     receiveIndex,
+			},
+			"change": map[string]interface{}{
+				"usedAddresses": changeAddresses,
+				"nextIndex":     changeIndex,
+			},
+		})
+	}
+	return accounts, nil
+}
+
+// AsyncBTCDiscoverAccounts finds the used single-sig accounts of the given script type, as
+// described in BIP44. The xpubs of the accounts 0, 1, ... are fetched from the device without
+// display, and their receive and change addresses are scanned up to the gap limit using isUsed.
+// Discovery stops at the first account without used receive addresses, or after
+// options.maxAccounts accounts, which is at most maxDiscoveryAccounts.
+func (device *jsDevice) AsyncBTCDiscoverAccounts(
+	done func([]interface{}, *jsError),
+	coin messages.BTCCoin,
+	simpleType messages.BTCScriptConfig_SimpleType,
+	options *btcDiscoverAccountsOptions,
+	isUsed *js.Object,
+) {
+	go func() {
+		purpose, ok := bip44Purposes[simpleType]
+		if !ok {
+			done(nil, toJSError(fmt.Errorf("unsupported script type: %s", simpleType)))
+			return
+		}
+		coinType, ok := bip44CoinTypes[coin]
+		if !ok {
+			done(nil, toJSError(fmt.Errorf("unsupported coin: %s", coin)))
+			return
+		}
+		if options.GapLimit == 0 || options.GapLimit > maxDiscoveryAddresses {
+			done(nil, toJSError(fmt.Errorf(
+				"gapLimit must be between 1 and %d", maxDiscoveryAddresses)))
+			return
+		}
+		if options.MaxAccounts == 0 || options.MaxAccounts > maxDiscoveryAccounts {
+			done(nil, toJSError(fmt.Errorf(
+				"maxAccounts must be between 1 and %d", maxDiscoveryAccounts)))
+			return
+		}
+		if simpleType == messages.BTCScriptConfig_P2TR {
+			if err := device.checkTaprootSupported(); err != nil {
+				done(nil, toJSError(err))
+				return
+			}
+			if _, ok := bip86CoinType(coin); !ok {
+				done(nil, toJSError(fmt.Errorf("taproot is not supported for %s", coin)))
+				return
+			}
+		}
+		accounts, err := discoverAccounts(
+			coin, simpleType, []uint32{purpose, coinType}, options.GapLimit, options.MaxAccounts,
+			func(keypathAccount []uint32) (string, error) {
+				return device.cachedXPub(coin, keypathAccount)
+			},
+			func(addresses []string) (interface{}, error) {
+				return addressesUsed(isUsed, addresses)
+			})
+		done(accounts, toJSError(err))
+	}()
+}
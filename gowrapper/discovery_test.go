@@ -0,0 +1,257 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+const testDiscoveryXPub = "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ"
+
+func testDiscoveryAccount(t *testing.T) *accountXPub {
+	t.Helper()
+	xpub, err := firmware.NewXPub(testDiscoveryXPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &accountXPub{
+		keypath: []uint32{84 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart},
+		xpub:    xpub,
+	}
+}
+
+func testAddress(t *testing.T, account *accountXPub, chain uint32, index uint32) string {
+	t.Helper()
+	address, err := simpleAddress(messages.BTCCoin_BTC, messages.BTCScriptConfig_P2WPKH,
+		account, append(append([]uint32{}, account.keypath...), chain, index))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return address
+}
+
+// testIsUsed returns an isUsed stub reporting the given addresses as used. The sizes of the batches
+// it is called with are recorded in batches.
+func testIsUsed(used map[string]bool, batches *[]int) func([]string) (interface{}, error) {
+	return func(addresses []string) (interface{}, error) {
+		*batches = append(*batches, len(addresses))
+		result := make([]interface{}, len(addresses))
+		for i, address := range addresses {
+			result[i] = used[address]
+		}
+		return result, nil
+	}
+}
+
+func TestScanChain(t *testing.T) {
+	account := testDiscoveryAccount(t)
+	tests := []struct {
+		name        string
+		gapLimit    uint32
+		usedIndexes []uint32
+		nextIndex   uint32
+		batches     []int
+	}{
+		{"unused", 5, nil, 0, []int{5}},
+		{"first address", 5, []uint32{0}, 1, []int{5, 1}},
+		{"gaps", 5, []uint32{0, 3}, 4, []int{5, 4}},
+		{"batch edge", 5, []uint32{4}, 5, []int{5, 5}},
+		{"beyond the gap limit", 5, []uint32{2, 6, 11, 17}, 12, []int{5, 3, 4, 5}},
+		{"gap limit 1", 1, []uint32{0, 1, 3}, 2, []int{1, 1, 1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			usedAddresses := map[string]bool{}
+			for _, index := range test.usedIndexes {
+				usedAddresses[testAddress(t, account, 0, index)] = true
+			}
+			var batches []int
+			used, nextIndex, err := scanChain(messages.BTCCoin_BTC, messages.BTCScriptConfig_P2WPKH,
+				account, 0, test.gapLimit, testIsUsed(usedAddresses, &batches))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if nextIndex != test.nextIndex {
+				t.Errorf("got nextIndex %d, expected %d", nextIndex, test.nextIndex)
+			}
+			if !reflect.DeepEqual(batches, test.batches) {
+				t.Errorf("got batches %v, expected %v", batches, test.batches)
+			}
+			var usedIndexes []uint32
+			for _, usedAddress := range used {
+				keypath := usedAddress.(map[string]interface{})["keypath"].([]interface{})
+				if len(keypath) != 5 || keypath[3] != uint32(0) {
+					t.Fatalf("unexpected keypath %v", keypath)
+				}
+				usedIndexes = append(usedIndexes, keypath[4].(uint32))
+			}
+			var expectedIndexes []uint32
+			for _, index := range test.usedIndexes {
+				if index < test.nextIndex {
+					expectedIndexes = append(expectedIndexes, index)
+				}
+			}
+			if !reflect.DeepEqual(usedIndexes, expectedIndexes) {
+				t.Errorf("got used indexes %v, expected %v", usedIndexes, expectedIndexes)
+			}
+		})
+	}
+}
+
+func TestDiscoverAccounts(t *testing.T) {
+	keypathPrefix := []uint32{84 + hardenedKeyStart, 0 + hardenedKeyStart}
+	xpubs := []string{testDiscoveryXPub, testXPub1, testXPub2}
+	accounts := make([]*accountXPub, len(xpubs))
+	for i, xpubStr := range xpubs {
+		xpub, err := firmware.NewXPub(xpubStr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		accounts[i] = &accountXPub{
+			keypath: append(append([]uint32{}, keypathPrefix...), uint32(i)+hardenedKeyStart),
+			xpub:    xpub,
+		}
+	}
+	// Account 0 has used receive and change addresses, account 1 only a used receive address and
+	// account 2 only a used change address, which does not make it a used account.
+	used := map[string]bool{
+		testAddress(t, accounts[0], 0, 0): true,
+		testAddress(t, accounts[0], 1, 2): true,
+		testAddress(t, accounts[1], 0, 3): true,
+		testAddress(t, accounts[2], 1, 0): true,
+	}
+	var fetched [][]uint32
+	xpub := func(keypathAccount []uint32) (string, error) {
+		fetched = append(fetched, keypathAccount)
+		return xpubs[keypathAccount[2]-hardenedKeyStart], nil
+	}
+	var batches []int
+	result, err := discoverAccounts(messages.BTCCoin_BTC, messages.BTCScriptConfig_P2WPKH,
+		keypathPrefix, 5, 10, xpub, testIsUsed(used, &batches))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("got %d accounts, expected 2", len(result))
+	}
+	// Discovery stops after the first unused account.
+	if len(fetched) != 3 {
+		t.Errorf("fetched %d xpubs, expected 3", len(fetched))
+	}
+	expectedNextIndexes := [][2]uint32{{1, 3}, {4, 0}}
+	for i, account := range result {
+		account := account.(map[string]interface{})
+		if account["account"] != uint32(i) || account["xpub"] != xpubs[i] {
+			t.Errorf("account %d: got %v", i, account)
+		}
+		receiveNext := account["receive"].(map[string]interface{})["nextIndex"]
+		changeNext := account["change"].(map[string]interface{})["nextIndex"]
+		if receiveNext != expectedNextIndexes[i][0] || changeNext != expectedNextIndexes[i][1] {
+			t.Errorf("account %d: got next indexes %v, %v, expected %v",
+				i, receiveNext, changeNext, expectedNextIndexes[i])
+		}
+	}
+	// The change chain of the unused account 2 is not scanned.
+	if !reflect.DeepEqual(batches, []int{5, 1, 5, 3, 5, 4, 5, 5}) {
+		t.Errorf("got batches %v", batches)
+	}
+
+	// maxAccounts limits the number of accounts even if they are all used.
+	fetched = nil
+	result, err = discoverAccounts(messages.BTCCoin_BTC, messages.BTCScriptConfig_P2WPKH,
+		keypathPrefix, 5, 1, xpub, testIsUsed(used, &batches))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || len(fetched) != 1 {
+		t.Errorf("got %d accounts, fetched %d xpubs, expected 1", len(result), len(fetched))
+	}
+
+	xpubErr := errors.New("device disconnected")
+	_, err = discoverAccounts(messages.BTCCoin_BTC, messages.BTCScriptConfig_P2WPKH,
+		keypathPrefix, 5, 10, func([]uint32) (string, error) { return "", xpubErr },
+		testIsUsed(used, &batches))
+	if err != xpubErr {
+		t.Errorf("expected %v, got %v", xpubErr, err)
+	}
+}
+
+func TestScanChainAllUsed(t *testing.T) {
+	isUsed := func(addresses []string) (interface{}, error) {
+		result := make([]interface{}, len(addresses))
+		for i := range result {
+			result[i] = true
+		}
+		return result, nil
+	}
+	_, _, err := scanChain(messages.BTCCoin_BTC, messages.BTCScriptConfig_P2WPKH,
+		testDiscoveryAccount(t), 0, 1000, isUsed)
+	if err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestScanChainErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		result func(addresses []string) interface{}
+	}{
+		{"not an array", func([]string) interface{} { return true }},
+		{"undefined", func([]string) interface{} { return nil }},
+		{"too short", func(addresses []string) interface{} {
+			return make([]interface{}, len(addresses)-1)
+		}},
+		{"too long", func(addresses []string) interface{} {
+			result := make([]interface{}, len(addresses)+1)
+			for i := range result {
+				result[i] = false
+			}
+			return result
+		}},
+		{"not booleans", func(addresses []string) interface{} {
+			result := make([]interface{}, len(addresses))
+			for i := range result {
+				result[i] = float64(0)
+			}
+			return result
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			isUsed := func(addresses []string) (interface{}, error) {
+				return test.result(addresses), nil
+			}
+			_, _, err := scanChain(messages.BTCCoin_BTC, messages.BTCScriptConfig_P2WPKH,
+				testDiscoveryAccount(t), 0, 5, isUsed)
+			if err == nil || err.Error() != "isUsed must return an array of 5 booleans" {
+				t.Errorf("got %v", err)
+			}
+		})
+	}
+
+	isUsedErr := errors.New("indexer unavailable")
+	_, _, err := scanChain(messages.BTCCoin_BTC, messages.BTCScriptConfig_P2WPKH,
+		testDiscoveryAccount(t), 0, 5, func([]string) (interface{}, error) {
+			return nil, isUsedErr
+		})
+	if err != isUsedErr {
+		t.Errorf("expected %v, got %v", isUsedErr, err)
+	}
+}
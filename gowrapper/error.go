@@ -25,8 +25,9 @@ import (
 type errorType string
 
 const (
-	errorTypeGeneric  = "generic"
-	errorTypeFirmware = "firmware"
+	errorTypeGeneric     = "generic"
+	errorTypeFirmware    = "firmware"
+	errorTypeUnsupported = "unsupported"
 )
 
 // jsError is a union of specific Go error types, with two way conversions between Go<->JS.
@@ -34,6 +35,9 @@ type jsError struct {
 	ErrorType errorType
 	Code      float64
 	Message   string
+	// RequiredVersion is the minimum firmware version supporting the feature, set for errors of type
+	// errorTypeUnsupported.
+	RequiredVersion string
 }
 
 func toJSError(err error) *jsError {
@@ -42,9 +46,11 @@ func toJSError(err error) *jsError {
 	}
 	switch e := errp.Cause(err).(type) {
 	case *firmware.Error:
-		return &jsError{errorTypeFirmware, float64(e.Code), e.Message}
+		return &jsError{errorTypeFirmware, float64(e.Code), e.Message, ""}
+	case firmware.UnsupportedError:
+		return &jsError{errorTypeUnsupported, 0, e.Error(), string(e)}
 	default:
-		return &jsError{errorTypeGeneric, 0, err.Error()}
+		return &jsError{errorTypeGeneric, 0, err.Error(), ""}
 	}
 }
 
@@ -56,6 +62,9 @@ func fromJSError(jsError map[string]interface{}) error {
 	switch jsError["ErrorType"] {
 	case errorTypeFirmware:
 		return firmware.NewError(int32(jsError["Code"].(float64)), msg)
+	case errorTypeUnsupported:
+		requiredVersion, _ := jsError["RequiredVersion"].(string)
+		return firmware.UnsupportedError(requiredVersion)
 	case errorTypeGeneric:
 		return errors.New(msg)
 	default:
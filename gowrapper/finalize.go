@@ -0,0 +1,155 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+// p2wpkhWitness returns the witness spending a P2WPKH witness program, which is the pubkey script
+// of P2WPKH and the redeem script of P2WPKH-P2SH. signature is DER encoded including the sighash
+// byte.
+func p2wpkhWitness(witnessProgram []byte, signature []byte, pubkey []byte) ([][]byte, error) {
+	if !bytes.Equal(hash160(pubkey), witnessProgram[2:]) {
+		return nil, errors.New("signature public key does not match the previous output")
+	}
+	return [][]byte{signature, pubkey}, nil
+}
+
+// signed returns the transaction with the scriptSigs and witnesses spending the inputs using the
+// given signatures.
+func (tx *simpleTx) signed(signatures [][]byte) (*rawTx, error) {
+	if len(signatures) != len(tx.unsigned.Inputs) {
+		return nil, errors.New("unexpected number of signatures")
+	}
+	signed := &rawTx{
+		Version:  tx.unsigned.Version,
+		Outputs:  tx.unsigned.Outputs,
+		Locktime: tx.unsigned.Locktime,
+	}
+	for i, input := range tx.unsigned.Inputs {
+		signedInput := *input
+		switch tx.simpleType {
+		case messages.BTCScriptConfig_P2TR:
+			// SIGHASH_DEFAULT, no sighash byte.
+			signedInput.Witness = [][]byte{signatures[i]}
+		case messages.BTCScriptConfig_P2WPKH, messages.BTCScriptConfig_P2WPKH_P2SH:
+			witnessProgram, err := outputPkScript(messages.BTCOutputType_P2WPKH, tx.pubkeyHash(i))
+			if err != nil {
+				return nil, err
+			}
+			signedInput.Witness, err = p2wpkhWitness(
+				witnessProgram,
+				append(derSignature(signatures[i]), 0x01), // SIGHASH_ALL
+				tx.pubkeys[i].SerializeCompressed(),
+			)
+			if err != nil {
+				return nil, err
+			}
+			if tx.simpleType == messages.BTCScriptConfig_P2WPKH_P2SH {
+				signedInput.ScriptSig = pushData(witnessProgram)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported script type: %s", tx.simpleType)
+		}
+		signed.Inputs = append(signed.Inputs, &signedInput)
+	}
+	return signed, nil
+}
+
+// finalize verifies the signatures, one per input, and returns the signed transaction as an object
+// with the keys "tx", the hex encoded transaction, "txid" and "wtxid".
+func (tx *simpleTx) finalize(signatures [][]byte) (map[string]interface{}, error) {
+	if err := tx.verify(signatures); err != nil {
+		return nil, err
+	}
+	signed, err := tx.signed(signatures)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"tx":    hex.EncodeToString(signed.serialize(true)),
+		"txid":  hashHex(signed.txid()),
+		"wtxid": hashHex(signed.wtxid()),
+	}, nil
+}
+
+// btcFinalizePSBT finalizes all inputs of a fully signed, base64 encoded PSBT and extracts the
+// signed transaction. Returns an object with the keys "psbt", the finalized PSBT, and "tx", the
+// hex encoded transaction ready for broadcast.
+func btcFinalizePSBT(psbtBase64 string) (map[string]interface{}, *jsError) {
+	p, err := parsePSBT(psbtBase64)
+	if err != nil {
+		return nil, toJSError(err)
+	}
+	if err := p.finalize(); err != nil {
+		return nil, toJSError(err)
+	}
+	tx, err := p.extract()
+	if err != nil {
+		return nil, toJSError(err)
+	}
+	return map[string]interface{}{
+		"psbt": p.base64(),
+		"tx":   hex.EncodeToString(tx.serialize(true)),
+	}, nil
+}
+
+// btcFinalizeSimple builds the signed transaction of a single-sig account from the signatures
+// returned by the device, one per input, after verifying them against the account xpub. The inputs
+// and outputs are the same as passed to AsyncBTCSignSimple.
+func btcFinalizeSimple(
+	coin messages.BTCCoin,
+	simpleType messages.BTCScriptConfig_SimpleType,
+	xpub string,
+	keypathAccount []uint32,
+	inputs []*btcSignInputRequest,
+	outputs []*btcSignOutputRequest,
+	version uint32,
+	locktime uint32,
+	signatures [][]byte,
+) (map[string]interface{}, *jsError) {
+	parsedXPub, err := firmware.NewXPub(xpub)
+	if err != nil {
+		return nil, toJSError(err)
+	}
+	theInputs, theOutputs, err := convertInputsAndOutputs(coin, inputs, outputs)
+	if err != nil {
+		return nil, toJSError(err)
+	}
+	unsigned, err := newSimpleTx(
+		&accountXPub{keypath: keypathAccount, xpub: parsedXPub},
+		simpleType,
+		&firmware.BTCTx{
+			Version:  version,
+			Inputs:   theInputs,
+			Outputs:  theOutputs,
+			Locktime: locktime,
+		})
+	if err != nil {
+		return nil, toJSError(err)
+	}
+	result, err := unsigned.finalize(signatures)
+	if err != nil {
+		return nil, toJSError(err)
+	}
+	return result, nil
+}
@@ -3,7 +3,14 @@ module bitbox02-api-js
 go 1.14
 
 require (
+	github.com/btcsuite/btcd/btcec/v2 v2.3.2
+	github.com/btcsuite/btcd/btcutil v1.1.3
+	github.com/btcsuite/btcd/chaincfg/chainhash v1.0.1
 	github.com/digitalbitbox/bitbox02-api-go v0.0.0-20230828131559-8aaeb1fdf18e
 	github.com/flynn/noise v1.0.0
 	github.com/gopherjs/gopherjs v0.0.0-20200217142428-fce0ec30dd00
+	golang.org/x/crypto v0.5.0
+	google.golang.org/protobuf v1.28.1
 )
+
+replace github.com/digitalbitbox/bitbox02-api-go => ./third_party/bitbox02-api-go
@@ -18,9 +18,11 @@ package main
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"log"
 	"math/big"
+	"sync"
 
 	"github.com/digitalbitbox/bitbox02-api-go/api/common"
 	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
@@ -34,7 +36,6 @@ const bitboxCMD = 0x80 + 0x40 + 0x01
 // whitelistedFirmwareMethods are exposed as-is from firmare.Device.
 var whitelistedFirmwareMethods = map[string]*struct{}{
 	"Attestation":   nil,
-	"SetOnEvent":    nil,
 	"Status":        nil,
 	"ChannelHash":   nil,
 	"Product":       nil,
@@ -79,8 +80,23 @@ func main() {
 		"IsErrorAbort": func(jsError map[string]interface{}) bool {
 			return firmware.IsErrorAbort(fromJSError(jsError))
 		},
-		"NewDeviceBridge": newJSDeviceBridge,
-		"NewDeviceWebHID": newJSDeviceWebHID,
+		"IsErrorUnsupported": func(jsError map[string]interface{}) bool {
+			_, ok := fromJSError(jsError).(firmware.UnsupportedError)
+			return ok
+		},
+		"BTCFinalizePSBT":          btcFinalizePSBT,
+		"BTCDeriveAddressSimple":   btcDeriveAddressSimple,
+		"BTCDeriveAddressMultisig": btcDeriveAddressMultisig,
+		"BTCFinalizeSimple":        btcFinalizeSimple,
+		"BTCFinalizeMultisig":      btcFinalizeMultisig,
+		"BTCParseMultisigSetup":    btcParseMultisigSetup,
+		"BTCFormatMultisigSetup":   btcFormatMultisigSetup,
+		"BIP86AccountKeypath":      bip86AccountKeypath,
+		"IsBIP86Keypath":           isBIP86Keypath,
+		"NewDeviceBridge":          newJSDeviceBridge,
+		"NewDeviceWebHID":          newJSDeviceWebHID,
+		"NewBootloaderBridge":      newJSBootloaderBridge,
+		"NewBootloaderWebHID":      newJSBootloaderWebHID,
 		"constants": map[string]interface{}{
 			"Product": map[string]interface{}{
 				"BitBox02Multi":      common.ProductBitBox02Multi,
@@ -98,18 +114,22 @@ func main() {
 				"RequireAppUpgrade":      firmware.StatusRequireAppUpgrade,
 			},
 			"Event": map[string]interface{}{
-				"ChannelHashChanged":   firmware.EventChannelHashChanged,
-				"StatusChanged":        firmware.EventStatusChanged,
-				"AttestationCheckDone": firmware.EventAttestationCheckDone,
+				"ChannelHashChanged":       firmware.EventChannelHashChanged,
+				"StatusChanged":            firmware.EventStatusChanged,
+				"AttestationCheckDone":     firmware.EventAttestationCheckDone,
+				"PassphraseSettingChanged": eventPassphraseSettingChanged,
 			},
+			"DeviceLanguages": deviceLanguages,
 			"messages": map[string]interface{}{
-				"ETHCoin":                    messages.ETHCoin_value,
-				"ETHPubRequest_OutputType":   messages.ETHPubRequest_OutputType_value,
-				"BTCCoin":                    messages.BTCCoin_value,
-				"BTCScriptConfig_SimpleType": messages.BTCScriptConfig_SimpleType_value,
-				"BTCOutputType":              messages.BTCOutputType_value,
-				"BTCXPubType":                messages.BTCPubRequest_XPubType_value,
-				"CardanoNetwork":             messages.CardanoNetwork_value,
+				"ETHCoin":                                messages.ETHCoin_value,
+				"ETHPubRequest_OutputType":               messages.ETHPubRequest_OutputType_value,
+				"BTCCoin":                                messages.BTCCoin_value,
+				"BTCScriptConfig_SimpleType":             messages.BTCScriptConfig_SimpleType_value,
+				"BTCScriptConfig_Multisig_ScriptType":    messages.BTCScriptConfig_Multisig_ScriptType_value,
+				"BTCOutputType":                          messages.BTCOutputType_value,
+				"BTCXPubType":                            messages.BTCPubRequest_XPubType_value,
+				"CardanoNetwork":                         messages.CardanoNetwork_value,
+				"InsertRemoveSDCardRequest_SDCardAction": messages.InsertRemoveSDCardRequest_SDCardAction_value,
 			},
 		},
 	})
@@ -130,26 +150,54 @@ func (r readWriteCloser) Write(p []byte) (n int, err error) {
 
 func (r readWriteCloser) Close() error { return nil }
 
-func newJSDevice(communication firmware.Communication, readChan chan []byte) *js.Object {
-	device := firmware.NewDevice(nil, nil, &config{}, communication, &bitbox02Logger{})
-	// TODO: construct directly from whitelist instead of deleting. The way GopherJS
-	// works, there is no js file size savings doing that, so deleting after is okay for
-	// now.
-	obj := js.MakeWrapper(device)
-	for _, key := range js.Keys(obj) {
-		if _, ok := whitelistedFirmwareMethods[key]; !ok {
-			obj.Delete(key)
-		}
+// errConnectionClosed is returned by pending and future queries once the connection was closed,
+// e.g. because the device rebooted.
+var errConnectionClosed = errors.New("connection closed")
 
+// jsConnection passes the messages read by the JavaScript transport (BitBoxBridge websocket or
+// WebHID) to the pending query.
+type jsConnection struct {
+	readChan chan []byte
+	// closed is closed by OnClose() to abort pending reads.
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newJSConnection() *jsConnection {
+	return &jsConnection{
+		readChan: make(chan []byte),
+		closed:   make(chan struct{}),
 	}
-	wrapped := &jsDevice{device, readChan}
-	obj.Set("js", js.MakeWrapper(wrapped))
-	return obj
 }
 
-func newJSDeviceBridge(onWrite func([]byte)) *js.Object {
-	readChan := make(chan []byte)
-	communication := &bb02Communication{
+func (connection *jsConnection) read() ([]byte, error) {
+	select {
+	case msg := <-connection.readChan:
+		return msg, nil
+	case <-connection.closed:
+		return nil, errConnectionClosed
+	}
+}
+
+// OnRead must be called with every message read from the transport.
+func (connection *jsConnection) OnRead(msg []byte) {
+	select {
+	case connection.readChan <- msg:
+	case <-connection.closed:
+	}
+}
+
+// OnClose must be called when the connection to the device was closed. Pending and future queries
+// fail with errConnectionClosed instead of waiting for a response forever.
+func (connection *jsConnection) OnClose() {
+	connection.closeOnce.Do(func() { close(connection.closed) })
+}
+
+// bridgeCommunication sends queries framed as u2fhid messages with the given command through the
+// BitBoxBridge.
+func (connection *jsConnection) bridgeCommunication(
+	onWrite func([]byte), cmd byte) firmware.Communication {
+	return &bb02Communication{
 		query: func(msg []byte) ([]byte, error) {
 			dataLen := len(msg)
 			if dataLen > 0xFFFF {
@@ -161,7 +209,7 @@ func newJSDeviceBridge(onWrite func([]byte)) *js.Object {
 			if err := binary.Write(&packet, binary.BigEndian, uint32(cid)); err != nil {
 				panic(err)
 			}
-			if err := binary.Write(&packet, binary.BigEndian, byte(bitboxCMD)); err != nil {
+			if err := binary.Write(&packet, binary.BigEndian, cmd); err != nil {
 				panic(err)
 			}
 			if err := binary.Write(&packet, binary.BigEndian, uint16(dataLen&0xFFFF)); err != nil {
@@ -169,21 +217,27 @@ func newJSDeviceBridge(onWrite func([]byte)) *js.Object {
 			}
 			packet.Write(msg)
 			onWrite(packet.Bytes())
-			readMsg := <-readChan
+			readMsg, err := connection.read()
+			if err != nil {
+				return nil, err
+			}
 			readMsg = readMsg[7:] // TODO: parse and verify u2f header
 			return readMsg, nil
 		},
 		close: func() {},
 	}
-	return newJSDevice(communication, readChan)
 }
 
-func newJSDeviceWebHID(onWrite func([]byte)) *js.Object {
-	readChan := make(chan []byte)
-	communication := u2fhid.NewCommunication(
+// webHIDCommunication sends queries as u2fhid packets with the given command through WebHID.
+func (connection *jsConnection) webHIDCommunication(
+	onWrite func([]byte), cmd byte) firmware.Communication {
+	return u2fhid.NewCommunication(
 		&readWriteCloser{
 			read: func(p []byte) (n int, err error) {
-				b := <-readChan
+				b, err := connection.read()
+				if err != nil {
+					return 0, err
+				}
 				return copy(p, b), nil
 			},
 			write: func(p []byte) (n int, err error) {
@@ -191,9 +245,36 @@ func newJSDeviceWebHID(onWrite func([]byte)) *js.Object {
 				return len(p), nil
 			},
 		},
-		bitboxCMD,
+		cmd,
 	)
-	return newJSDevice(communication, readChan)
+}
+
+func newJSDevice(communication firmware.Communication, connection *jsConnection) *js.Object {
+	device := firmware.NewDevice(nil, nil, &config{}, communication, &bitbox02Logger{})
+	// TODO: construct directly from whitelist instead of deleting. The way GopherJS
+	// works, there is no js file size savings doing that, so deleting after is okay for
+	// now.
+	obj := js.MakeWrapper(device)
+	for _, key := range js.Keys(obj) {
+		if _, ok := whitelistedFirmwareMethods[key]; !ok {
+			obj.Delete(key)
+		}
+
+	}
+	wrapped := &jsDevice{jsConnection: connection, device: device}
+	obj.Set("SetOnEvent", wrapped.setOnEvent)
+	obj.Set("js", js.MakeWrapper(wrapped))
+	return obj
+}
+
+func newJSDeviceBridge(onWrite func([]byte)) *js.Object {
+	connection := newJSConnection()
+	return newJSDevice(connection.bridgeCommunication(onWrite, bitboxCMD), connection)
+}
+
+func newJSDeviceWebHID(onWrite func([]byte)) *js.Object {
+	connection := newJSConnection()
+	return newJSDevice(connection.webHIDCommunication(onWrite, bitboxCMD), connection)
 }
 
 // jsDevice adds additional device methods to be exposed to JavaScript.
@@ -202,18 +283,42 @@ func newJSDeviceWebHID(onWrite func([]byte)) *js.Object {
 // the first argument being the error, and the rest of the arguments being regular result values.
 // Those functions can be used as promises in JavaScript.
 type jsDevice struct {
-	device   *firmware.Device
-	readChan chan<- []byte
+	*jsConnection
+	device *firmware.Device
+
+	mu      sync.RWMutex
+	onEvent func(firmware.Event, interface{})
+	// xpubs caches the account xpubs fetched without display, by coin and keypath.
+	xpubs map[string]string
+}
+
+// eventPassphraseSettingChanged is fired by the wrapper when the optional passphrase was enabled or
+// disabled. The setting takes effect the next time the device is unlocked; until then, the device
+// keeps working with the current wallet.
+const eventPassphraseSettingChanged firmware.Event = "passphraseSettingChanged"
+
+// setOnEvent replaces firmware.Device.SetOnEvent, so that events fired by the wrapper reach the
+// same callback as the device events.
+func (device *jsDevice) setOnEvent(onEvent func(firmware.Event, interface{})) {
+	device.mu.Lock()
+	device.onEvent = onEvent
+	device.mu.Unlock()
+	device.device.SetOnEvent(onEvent)
+}
+
+func (device *jsDevice) fireEvent(event firmware.Event) {
+	device.mu.RLock()
+	f := device.onEvent
+	device.mu.RUnlock()
+	if f != nil {
+		f(event, nil)
+	}
 }
 
 func (device *jsDevice) Version() string {
 	return device.device.Version().String()
 }
 
-func (device *jsDevice) OnRead(msg []byte) {
-	device.readChan <- msg
-}
-
 func (device *jsDevice) AsyncInit(done func(*jsError)) {
 	go func() {
 		done(toJSError(device.device.Init()))
@@ -239,6 +344,17 @@ func (device *jsDevice) AsyncBTCXPub(
 	}()
 }
 
+// AsyncElectrumEncryptionKey returns the xpub Electrum uses to derive the wallet file encryption
+// key. The keypath has to be m/4541509'/1112098098'.
+func (device *jsDevice) AsyncElectrumEncryptionKey(
+	done func(string, *jsError),
+	keypath []uint32) {
+	go func() {
+		key, err := device.device.ElectrumEncryptionKey(keypath)
+		done(key, toJSError(err))
+	}()
+}
+
 func (device *jsDevice) AsyncBTCAddressSimple(
 	done func(string, *jsError),
 	coin messages.BTCCoin,
@@ -246,6 +362,16 @@ func (device *jsDevice) AsyncBTCAddressSimple(
 	simpleType messages.BTCScriptConfig_SimpleType,
 	display bool) {
 	go func() {
+		if simpleType == messages.BTCScriptConfig_P2TR {
+			if err := device.checkTaprootSupported(); err != nil {
+				done("", toJSError(err))
+				return
+			}
+			if err := validateBIP86Keypath(coin, keypath, true); err != nil {
+				done("", toJSError(err))
+				return
+			}
+		}
 		address, err := device.device.BTCAddress(
 			coin,
 			keypath,
@@ -279,20 +405,37 @@ type btcPrevTx struct {
 
 type btcSignInputRequest struct {
 	*js.Object
-	PrevOutHash  []byte    `js:"prevOutHash"`
-	PrevOutIndex uint32    `js:"prevOutIndex"`
-	PrevOutValue string    `js:"prevOutValue"`
-	Sequence     uint32    `js:"sequence"`
-	Keypath      []uint32  `js:"keypath"`
-	PrevTx       btcPrevTx `js:"prevTx"`
+	PrevOutHash       []byte    `js:"prevOutHash"`
+	PrevOutIndex      uint32    `js:"prevOutIndex"`
+	PrevOutValue      string    `js:"prevOutValue"`
+	Sequence          uint32    `js:"sequence"`
+	Keypath           []uint32  `js:"keypath"`
+	ScriptConfigIndex uint32    `js:"scriptConfigIndex"`
+	PrevTx            btcPrevTx `js:"prevTx"`
+	// PrevTxRaw can be set instead of PrevTx, the hex encoded previous transaction.
+	PrevTxRaw string `js:"prevTxRaw"`
+}
+
+// rawPrevTx parses PrevTxRaw and checks that it is the transaction referenced by the input.
+func (input *btcSignInputRequest) rawPrevTx() (*firmware.BTCPrevTx, error) {
+	raw, err := hex.DecodeString(input.PrevTxRaw)
+	if err != nil {
+		return nil, errors.New("expected hex string as prevTxRaw")
+	}
+	prevTx, err := parseRawTx(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(prevTx.txid(), input.PrevOutHash) {
+		return nil, errors.New("prevTxRaw does not match prevOutHash")
+	}
+	if int(input.PrevOutIndex) >= len(prevTx.Outputs) {
+		return nil, errors.New("prevOutIndex out of range of prevTxRaw")
+	}
+	return prevTx.toPrevTx(), nil
 }
 
-func (input *btcSignInputRequest) toInput() (*firmware.BTCTxInput, error) {
-	int, ok := new(big.Int).SetString(input.PrevOutValue, 10)
-	if !ok {
-		return nil, errors.New("expected decimal string as value")
-	}
-	prevTx := input.PrevTx
+func (prevTx *btcPrevTx) toPrevTx() (*firmware.BTCPrevTx, error) {
 	prevInputs := make([]*messages.BTCPrevTxInputRequest, len(prevTx.Inputs))
 	for i, input := range prevTx.Inputs {
 		prevInputs[i] = &messages.BTCPrevTxInputRequest{
@@ -313,6 +456,29 @@ func (input *btcSignInputRequest) toInput() (*firmware.BTCTxInput, error) {
 			PubkeyScript: output.PubkeyScript,
 		}
 	}
+	return &firmware.BTCPrevTx{
+		Version:  prevTx.Version,
+		Inputs:   prevInputs,
+		Outputs:  prevOutputs,
+		Locktime: prevTx.Locktime,
+	}, nil
+}
+
+func (input *btcSignInputRequest) toInput() (*firmware.BTCTxInput, error) {
+	int, ok := new(big.Int).SetString(input.PrevOutValue, 10)
+	if !ok {
+		return nil, errors.New("expected decimal string as value")
+	}
+	var prevTx *firmware.BTCPrevTx
+	var err error
+	if input.PrevTxRaw != "" {
+		prevTx, err = input.rawPrevTx()
+	} else {
+		prevTx, err = input.PrevTx.toPrevTx()
+	}
+	if err != nil {
+		return nil, err
+	}
 	return &firmware.BTCTxInput{
 		Input: &messages.BTCSignInputRequest{
 			PrevOutHash:       input.PrevOutHash,
@@ -320,41 +486,52 @@ func (input *btcSignInputRequest) toInput() (*firmware.BTCTxInput, error) {
 			PrevOutValue:      int.Uint64(),
 			Sequence:          input.Sequence,
 			Keypath:           input.Keypath,
-			ScriptConfigIndex: 0,
-		},
-		PrevTx: &firmware.BTCPrevTx{
-			Version:  prevTx.Version,
-			Inputs:   prevInputs,
-			Outputs:  prevOutputs,
-			Locktime: prevTx.Locktime,
+			ScriptConfigIndex: input.ScriptConfigIndex,
 		},
+		PrevTx: prevTx,
 	}, nil
 }
 
 type btcSignOutputRequest struct {
 	*js.Object
-	Ours    bool                   `js:"ours"`
-	Type    messages.BTCOutputType `js:"type"`
-	Value   string                 `js:"value"`
-	Payload []byte                 `js:"payload"`
-	Keypath []uint32               `js:"keypath"`
+	Ours              bool                   `js:"ours"`
+	Type              messages.BTCOutputType `js:"type"`
+	Value             string                 `js:"value"`
+	Payload           []byte                 `js:"payload"`
+	Keypath           []uint32               `js:"keypath"`
+	ScriptConfigIndex uint32                 `js:"scriptConfigIndex"`
+	// Address can be set instead of Type and Payload for outputs that are not ours.
+	Address string `js:"address"`
 }
 
-func (output *btcSignOutputRequest) toOutput() (*messages.BTCSignOutputRequest, error) {
+func (output *btcSignOutputRequest) toOutput(coin messages.BTCCoin) (*messages.BTCSignOutputRequest, error) {
 	int, ok := new(big.Int).SetString(output.Value, 10)
 	if !ok {
 		return nil, errors.New("expected decimal string as value")
 	}
+	outputType, payload := output.Type, output.Payload
+	if output.Address != "" {
+		if output.Ours || outputType != messages.BTCOutputType_UNKNOWN || len(payload) != 0 {
+			return nil, errors.New("address must only be set instead of type and payload")
+		}
+		var err error
+		outputType, payload, err = decodeAddress(coin, output.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &messages.BTCSignOutputRequest{
-		Ours:    output.Ours,
-		Type:    output.Type,
-		Value:   int.Uint64(),
-		Payload: output.Payload,
-		Keypath: output.Keypath,
+		Ours:              output.Ours,
+		Type:              outputType,
+		Value:             int.Uint64(),
+		Payload:           payload,
+		Keypath:           output.Keypath,
+		ScriptConfigIndex: output.ScriptConfigIndex,
 	}, nil
 }
 
 func convertInputsAndOutputs(
+	coin messages.BTCCoin,
 	inputs []*btcSignInputRequest,
 	outputs []*btcSignOutputRequest,
 ) ([]*firmware.BTCTxInput, []*messages.BTCSignOutputRequest, error) {
@@ -369,7 +546,7 @@ func convertInputsAndOutputs(
 	theOutputs := make([]*messages.BTCSignOutputRequest, len(outputs))
 	for i, output := range outputs {
 		var err error
-		theOutputs[i], err = output.toOutput()
+		theOutputs[i], err = output.toOutput(coin)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -377,6 +554,54 @@ func convertInputsAndOutputs(
 	return theInputs, theOutputs, nil
 }
 
+// btcSignSimple signs a single-sig transaction. Besides the signatures, one per input, it returns
+// the transaction to verify them against, built from the account xpub. The xpub is fetched from the
+// device only the first time an account is used (see cachedXPub).
+func (device *jsDevice) btcSignSimple(
+	coin messages.BTCCoin,
+	simpleType messages.BTCScriptConfig_SimpleType,
+	keypathAccount []uint32,
+	inputs []*btcSignInputRequest,
+	outputs []*btcSignOutputRequest,
+	version uint32,
+	locktime uint32,
+) (*simpleTx, [][]byte, error) {
+	theInputs, theOutputs, err := convertInputsAndOutputs(coin, inputs, outputs)
+	if err != nil {
+		return nil, nil, err
+	}
+	tx := &firmware.BTCTx{
+		Version:  version,
+		Inputs:   theInputs,
+		Outputs:  theOutputs,
+		Locktime: locktime,
+	}
+	scriptConfigs := []*messages.BTCScriptConfigWithKeypath{{
+		ScriptConfig: firmware.NewBTCScriptConfigSimple(simpleType),
+		Keypath:      keypathAccount,
+	}}
+	if err := device.checkSign(coin, scriptConfigs, tx); err != nil {
+		return nil, nil, err
+	}
+	signatures, err := device.device.BTCSign(
+		coin, scriptConfigs, tx, messages.BTCSignInitRequest_DEFAULT)
+	if err != nil {
+		return nil, nil, err
+	}
+	account, err := device.accountXPub(coin, keypathAccount)
+	if err != nil {
+		return nil, nil, err
+	}
+	unsigned, err := newSimpleTx(account, simpleType, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return unsigned, signatures, nil
+}
+
+// AsyncBTCSignSimple signs a single-sig transaction. The signatures, one per input, are verified
+// against the account xpub before returning them, so that a signature not valid for the
+// transaction is caught here instead of when broadcasting.
 func (device *jsDevice) AsyncBTCSignSimple(
 	done func([][]byte, *jsError),
 	coin messages.BTCCoin,
@@ -388,43 +613,65 @@ func (device *jsDevice) AsyncBTCSignSimple(
 	locktime uint32,
 ) {
 	go func() {
-		theInputs, theOutputs, err := convertInputsAndOutputs(inputs, outputs)
+		unsigned, signatures, err := device.btcSignSimple(
+			coin, simpleType, keypathAccount, inputs, outputs, version, locktime)
 		if err != nil {
 			done(nil, toJSError(err))
 			return
 		}
-		signatures, err := device.device.BTCSign(
-			coin,
-			[]*messages.BTCScriptConfigWithKeypath{{
-				ScriptConfig: firmware.NewBTCScriptConfigSimple(simpleType),
-				Keypath:      keypathAccount,
-			}},
-			&firmware.BTCTx{
-				Version:  version,
-				Inputs:   theInputs,
-				Outputs:  theOutputs,
-				Locktime: locktime,
-			},
-			messages.BTCSignInitRequest_DEFAULT,
-		)
-		done(signatures, toJSError(err))
+		if err := unsigned.verify(signatures); err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		done(signatures, nil)
+	}()
+}
+
+// AsyncBTCSignSimpleTx is like AsyncBTCSignSimple, but returns an object with the signatures, the
+// hex encoded signed transaction and its txid and wtxid.
+func (device *jsDevice) AsyncBTCSignSimpleTx(
+	done func(map[string]interface{}, *jsError),
+	coin messages.BTCCoin,
+	simpleType messages.BTCScriptConfig_SimpleType,
+	keypathAccount []uint32,
+	inputs []*btcSignInputRequest,
+	outputs []*btcSignOutputRequest,
+	version uint32,
+	locktime uint32,
+) {
+	go func() {
+		unsigned, signatures, err := device.btcSignSimple(
+			coin, simpleType, keypathAccount, inputs, outputs, version, locktime)
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		result, err := unsigned.finalize(signatures)
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		result["signatures"] = signatures
+		done(result, nil)
 	}()
 }
 
 type btcMultisigConfig struct {
 	*js.Object
-	Coin           messages.BTCCoin `js:"coin"`
-	KeypathAccount []uint32         `js:"keypathAccount"`
-	Threshold      uint32           `js:"threshold"`
-	XPubs          []string         `js:"xpubs"`
-	OurXPubIndex   uint32           `js:"ourXPubIndex"`
+	Coin           messages.BTCCoin                             `js:"coin"`
+	KeypathAccount []uint32                                     `js:"keypathAccount"`
+	Threshold      uint32                                       `js:"threshold"`
+	XPubs          []string                                     `js:"xpubs"`
+	OurXPubIndex   uint32                                       `js:"ourXPubIndex"`
+	ScriptType     messages.BTCScriptConfig_Multisig_ScriptType `js:"scriptType"`
 }
 
 func (config *btcMultisigConfig) toScriptConfig() (*messages.BTCScriptConfig, error) {
-	return firmware.NewBTCScriptConfigMultisig(
+	return newBTCScriptConfigMultisig(
 		config.Threshold,
 		config.XPubs,
 		config.OurXPubIndex,
+		config.ScriptType,
 	)
 }
 
@@ -438,6 +685,10 @@ func (device *jsDevice) AsyncBTCIsScriptConfigRegistered(
 			done(false, toJSError(err))
 			return
 		}
+		if err := device.checkScriptConfigSupported(conf); err != nil {
+			done(false, toJSError(err))
+			return
+		}
 		result, err := device.device.BTCIsScriptConfigRegistered(
 			scriptConfig.Coin, conf, scriptConfig.KeypathAccount)
 		done(result, toJSError(err))
@@ -454,6 +705,10 @@ func (device *jsDevice) AsyncBTCRegisterScriptConfig(
 			done(toJSError(err))
 			return
 		}
+		if err := device.checkScriptConfigSupported(conf); err != nil {
+			done(toJSError(err))
+			return
+		}
 		err = device.device.BTCRegisterScriptConfig(
 			scriptConfig.Coin,
 			conf,
@@ -475,6 +730,10 @@ func (device *jsDevice) AsyncBTCAddressMultisig(
 			done("", toJSError(err))
 			return
 		}
+		if err := device.checkScriptConfigSupported(conf); err != nil {
+			done("", toJSError(err))
+			return
+		}
 		address, err := device.device.BTCAddress(
 			scriptConfig.Coin,
 			keypath,
@@ -498,27 +757,38 @@ func (device *jsDevice) AsyncBTCSignMultisig(
 			done(nil, toJSError(err))
 			return
 		}
+		if err := device.checkScriptConfigSupported(conf); err != nil {
+			done(nil, toJSError(err))
+			return
+		}
 
-		theInputs, theOutputs, err := convertInputsAndOutputs(inputs, outputs)
+		theInputs, theOutputs, err := convertInputsAndOutputs(scriptConfig.Coin, inputs, outputs)
 		if err != nil {
 			done(nil, toJSError(err))
 			return
 		}
+		scriptConfigs := []*messages.BTCScriptConfigWithKeypath{{
+			ScriptConfig: conf,
+			Keypath:      scriptConfig.KeypathAccount,
+		}}
+		tx := &firmware.BTCTx{
+			Version:  version,
+			Inputs:   theInputs,
+			Outputs:  theOutputs,
+			Locktime: locktime,
+		}
 		signatures, err := device.device.BTCSign(
-			scriptConfig.Coin,
-			[]*messages.BTCScriptConfigWithKeypath{{
-				ScriptConfig: conf,
-				Keypath:      scriptConfig.KeypathAccount,
-			}},
-			&firmware.BTCTx{
-				Version:  version,
-				Inputs:   theInputs,
-				Outputs:  theOutputs,
-				Locktime: locktime,
-			},
-			messages.BTCSignInitRequest_DEFAULT,
-		)
-		done(signatures, toJSError(err))
+			scriptConfig.Coin, scriptConfigs, tx, messages.BTCSignInitRequest_DEFAULT)
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		if err := device.verifySignatures(
+			scriptConfig.Coin, scriptConfigs, tx, signatures); err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		done(signatures, nil)
 	}()
 }
 
@@ -529,6 +799,10 @@ func (device *jsDevice) AsyncBTCSignMessage(
 	keypath []uint32,
 	message []byte) {
 	go func() {
+		if simpleType == messages.BTCScriptConfig_P2TR {
+			done(nil, toJSError(errors.New("message signing is not supported for taproot")))
+			return
+		}
 		sig, recID, electrumSig65, err := device.device.BTCSignMessage(
 			coin,
 			&messages.BTCScriptConfigWithKeypath{
@@ -0,0 +1,45 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// AsyncShowMnemonic shows the BIP39 recovery words on the device and lets the user confirm them.
+// If the device is in StatusSeeded, the status changes to StatusInitialized on success.
+func (device *jsDevice) AsyncShowMnemonic(done func(*jsError)) {
+	go func() {
+		done(toJSError(device.device.ShowMnemonic()))
+	}()
+}
+
+// AsyncRestoreFromMnemonic lets the user enter their BIP39 recovery words and a new password on
+// the device. The device must be uninitialized. On success, the status changes to
+// StatusInitialized.
+func (device *jsDevice) AsyncRestoreFromMnemonic(done func(*jsError)) {
+	go func() {
+		done(toJSError(device.withSeedChange(device.device.RestoreFromMnemonic)))
+	}()
+}
+
+// AsyncSetMnemonicPassphraseEnabled enables or disables the optional BIP39 passphrase and fires
+// eventPassphraseSettingChanged. The change takes effect the next time the device is unlocked.
+func (device *jsDevice) AsyncSetMnemonicPassphraseEnabled(done func(*jsError), enabled bool) {
+	go func() {
+		if err := device.device.SetMnemonicPassphraseEnabled(enabled); err != nil {
+			done(toJSError(err))
+			return
+		}
+		device.fireEvent(eventPassphraseSettingChanged)
+		done(nil)
+	}()
+}
@@ -0,0 +1,223 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+// multisigAccount derives the scripts of a multisig account from the xpubs of all cosigners.
+type multisigAccount struct {
+	threshold    int
+	scriptType   messages.BTCScriptConfig_Multisig_ScriptType
+	xpubs        []*accountXPub
+	ourXPubIndex int
+}
+
+func newMultisigAccount(
+	multisig *messages.BTCScriptConfig_Multisig, keypathAccount []uint32,
+) *multisigAccount {
+	account := &multisigAccount{
+		threshold:    int(multisig.Threshold),
+		scriptType:   multisig.ScriptType,
+		ourXPubIndex: int(multisig.OurXpubIndex),
+	}
+	for _, xpub := range multisig.Xpubs {
+		account.xpubs = append(account.xpubs, &accountXPub{keypath: keypathAccount, xpub: xpub})
+	}
+	return account
+}
+
+// account validates the multisig config and returns the account to derive its scripts.
+func (config *btcMultisigConfig) account() (*multisigAccount, error) {
+	scriptConfig, err := config.toScriptConfig()
+	if err != nil {
+		return nil, err
+	}
+	return newMultisigAccount(scriptConfig.GetMultisig(), config.KeypathAccount), nil
+}
+
+// witnessScript returns the multisig script at the given keypath, with the public keys of all
+// cosigners sorted as in BIP67 (`sortedmulti()` in descriptors), as done by the device. The sorted
+// public keys are returned as well.
+func (account *multisigAccount) witnessScript(keypath []uint32) ([]byte, []*btcec.PublicKey, error) {
+	pubkeys := make([]*btcec.PublicKey, len(account.xpubs))
+	for i, xpub := range account.xpubs {
+		pubkey, err := xpub.pubkey(keypath)
+		if err != nil {
+			return nil, nil, err
+		}
+		pubkeys[i] = pubkey
+	}
+	sort.Slice(pubkeys, func(i, j int) bool {
+		return bytes.Compare(pubkeys[i].SerializeCompressed(), pubkeys[j].SerializeCompressed()) < 0
+	})
+	serialized := make([][]byte, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		serialized[i] = pubkey.SerializeCompressed()
+	}
+	return multisigScript(account.threshold, serialized), pubkeys, nil
+}
+
+// p2wshPkScript returns the P2WSH pubkey script of a witness script, which is also the redeem
+// script of P2WSH-P2SH.
+func p2wshPkScript(witnessScript []byte) ([]byte, error) {
+	scriptHash := sha256.Sum256(witnessScript)
+	return outputPkScript(messages.BTCOutputType_P2WSH, scriptHash[:])
+}
+
+// pkScript returns the pubkey script of the address at the given keypath.
+func (account *multisigAccount) pkScript(keypath []uint32) ([]byte, error) {
+	witnessScript, _, err := account.witnessScript(keypath)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := p2wshPkScript(witnessScript)
+	if err != nil {
+		return nil, err
+	}
+	if account.scriptType == messages.BTCScriptConfig_Multisig_P2WSH_P2SH {
+		return outputPkScript(messages.BTCOutputType_P2SH, hash160(pkScript))
+	}
+	return pkScript, nil
+}
+
+func (account *multisigAccount) verify(
+	tx *rawTx, prevOuts []*rawTxOut, index int, keypath []uint32, signature []byte,
+) (bool, error) {
+	witnessScript, _, err := account.witnessScript(keypath)
+	if err != nil {
+		return false, err
+	}
+	pubkey, err := account.xpubs[account.ourXPubIndex].pubkey(keypath)
+	if err != nil {
+		return false, err
+	}
+	return verifyECDSA(
+		signature, tx.sighashSegwitV0(index, witnessScript, prevOuts[index].Value), pubkey), nil
+}
+
+// finalizeMultisig combines the signatures of the cosigners and returns the signed transaction.
+// signatureSets contains one set of signatures per cosigner, with one 64 byte signature per input
+// as returned by the device, or an empty one for inputs not signed by this cosigner. Which
+// cosigner created a signature is found by verifying it against the public keys of the input.
+func finalizeMultisig(
+	account *multisigAccount, tx *firmware.BTCTx, signatureSets [][][]byte,
+) (*rawTx, error) {
+	signed, prevOuts, err := unsignedTx(tx, func(_ uint32, keypath []uint32) ([]byte, error) {
+		return account.pkScript(keypath)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for setIndex, signatures := range signatureSets {
+		if len(signatures) != len(tx.Inputs) {
+			return nil, fmt.Errorf("signature set %d has %d signatures, expected one per input (%d)",
+				setIndex, len(signatures), len(tx.Inputs))
+		}
+	}
+	for i, input := range tx.Inputs {
+		witnessScript, pubkeys, err := account.witnessScript(input.Input.Keypath)
+		if err != nil {
+			return nil, err
+		}
+		sighash := signed.sighashSegwitV0(i, witnessScript, prevOuts[i].Value)
+		// Signatures by index of the sorted public keys.
+		signaturesByPubkey := make([][]byte, len(pubkeys))
+		for setIndex, signatures := range signatureSets {
+			signature := signatures[i]
+			if len(signature) == 0 {
+				continue
+			}
+			found := false
+			for pubkeyIndex, pubkey := range pubkeys {
+				if verifyECDSA(signature, sighash, pubkey) {
+					signaturesByPubkey[pubkeyIndex] = signature
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("signature %d of input %d is invalid", setIndex, i)
+			}
+		}
+		// Empty item for the off-by-one bug of OP_CHECKMULTISIG, followed by the signatures in the
+		// order of the public keys in the script.
+		witness := [][]byte{{}}
+		for _, signature := range signaturesByPubkey {
+			if len(witness)-1 == account.threshold {
+				break
+			}
+			if signature != nil {
+				witness = append(witness, append(derSignature(signature), 0x01)) // SIGHASH_ALL
+			}
+		}
+		if len(witness)-1 != account.threshold {
+			return nil, fmt.Errorf("input %d has %d of %d required signatures",
+				i, len(witness)-1, account.threshold)
+		}
+		signed.Inputs[i].Witness = append(witness, witnessScript)
+		if account.scriptType == messages.BTCScriptConfig_Multisig_P2WSH_P2SH {
+			redeemScript, err := p2wshPkScript(witnessScript)
+			if err != nil {
+				return nil, err
+			}
+			signed.Inputs[i].ScriptSig = pushData(redeemScript)
+		}
+	}
+	return signed, nil
+}
+
+// btcFinalizeMultisig combines the signatures of multiple cosigners of a multisig account into the
+// signed transaction. The inputs and outputs are the same as passed to AsyncBTCSignMultisig.
+func btcFinalizeMultisig(
+	config *btcMultisigConfig,
+	inputs []*btcSignInputRequest,
+	outputs []*btcSignOutputRequest,
+	version uint32,
+	locktime uint32,
+	signatureSets [][][]byte,
+) (map[string]interface{}, *jsError) {
+	account, err := config.account()
+	if err != nil {
+		return nil, toJSError(err)
+	}
+	theInputs, theOutputs, err := convertInputsAndOutputs(config.Coin, inputs, outputs)
+	if err != nil {
+		return nil, toJSError(err)
+	}
+	signed, err := finalizeMultisig(account, &firmware.BTCTx{
+		Version:  version,
+		Inputs:   theInputs,
+		Outputs:  theOutputs,
+		Locktime: locktime,
+	}, signatureSets)
+	if err != nil {
+		return nil, toJSError(err)
+	}
+	return map[string]interface{}{
+		"tx":    hex.EncodeToString(signed.serialize(true)),
+		"txid":  hashHex(signed.txid()),
+		"wtxid": hashHex(signed.wtxid()),
+	}, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+// multisigTestKeys are the private keys of the cosigners of the 2-of-3 test account. Their public
+// keys are in BIP67 order, so the index of a key is also its position in the witness script.
+var multisigTestKeys = []string{
+	strings.Repeat("02", 32),
+	strings.Repeat("03", 32),
+	strings.Repeat("01", 32),
+}
+
+// multisigTestWitnessScript is the 2-of-3 witness script of the test account.
+const multisigTestWitnessScript = "5221" +
+	"024d4b6cd1361032ca9bd2aeb9d900aa4d45d9ead80ac9423374c451a7254d0766" + "21" +
+	"02531fe6068134503d2723133227c867ac8fa6c83c537e9a44c3c5bdbdcb1fe337" + "21" +
+	"031b84c5567b126440995d3ed5aaba0565d71e1834604819ff9c17f5e9d5dd078f" + "53ae"
+
+// multisigTestKeypath is used as the account keypath and as the input keypath, so that the
+// cosigner keys are used without derivation.
+var multisigTestKeypath = []uint32{
+	48 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart, 2 + hardenedKeyStart}
+
+func newMultisigTestAccount(
+	t *testing.T, scriptType messages.BTCScriptConfig_Multisig_ScriptType,
+) *multisigAccount {
+	t.Helper()
+	xpubs := make([]*messages.XPub, len(multisigTestKeys))
+	for i, key := range multisigTestKeys {
+		_, pubkey := btcec.PrivKeyFromBytes(unhex(t, key))
+		xpubs[i] = &messages.XPub{PublicKey: pubkey.SerializeCompressed(), ChainCode: make([]byte, 32)}
+	}
+	return newMultisigAccount(&messages.BTCScriptConfig_Multisig{
+		Threshold:  2,
+		Xpubs:      xpubs,
+		ScriptType: scriptType,
+	}, multisigTestKeypath)
+}
+
+func newMultisigTestTx(t *testing.T) *firmware.BTCTx {
+	t.Helper()
+	return &firmware.BTCTx{
+		Version: 2,
+		Inputs: []*firmware.BTCTxInput{{
+			Input: &messages.BTCSignInputRequest{
+				PrevOutHash:  unhex(t, "fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f"),
+				PrevOutIndex: 1,
+				PrevOutValue: 100000,
+				Sequence:     0xfffffffd,
+				Keypath:      multisigTestKeypath,
+			},
+		}},
+		Outputs: []*messages.BTCSignOutputRequest{{
+			Type:    messages.BTCOutputType_P2WPKH,
+			Value:   90000,
+			Payload: unhex(t, "751e76e8199196d454941c45d1b3a323f1433bd6"),
+		}},
+	}
+}
+
+// signMultisigTx signs all inputs of tx with the given private key the way the device does,
+// returning 64 byte signatures.
+func signMultisigTx(
+	t *testing.T, account *multisigAccount, tx *firmware.BTCTx, privKey string,
+) [][]byte {
+	t.Helper()
+	unsigned, prevOuts, err := unsignedTx(tx, func(_ uint32, keypath []uint32) ([]byte, error) {
+		return account.pkScript(keypath)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, _ := btcec.PrivKeyFromBytes(unhex(t, privKey))
+	signatures := make([][]byte, len(tx.Inputs))
+	for i, input := range tx.Inputs {
+		witnessScript, _, err := account.witnessScript(input.Input.Keypath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature, err := ecdsa.SignCompact(
+			key, unsigned.sighashSegwitV0(i, witnessScript, prevOuts[i].Value), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Strip the recovery id.
+		signatures[i] = signature[1:]
+	}
+	return signatures
+}
+
+func TestFinalizeMultisig(t *testing.T) {
+	tests := []struct {
+		scriptType messages.BTCScriptConfig_Multisig_ScriptType
+		scriptSig  string
+	}{
+		{messages.BTCScriptConfig_Multisig_P2WSH, ""},
+		{
+			messages.BTCScriptConfig_Multisig_P2WSH_P2SH,
+			"220020" + "a3379884c9919e8ae37a568e76b4af9d72b0928bf52f5ea8e5f53032691d17be",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.scriptType.String(), func(t *testing.T) {
+			account := newMultisigTestAccount(t, test.scriptType)
+			tx := newMultisigTestTx(t)
+			sig0 := signMultisigTx(t, account, tx, multisigTestKeys[0])
+			sig2 := signMultisigTx(t, account, tx, multisigTestKeys[2])
+			// The signature sets are given out of key order, and the second cosigner did not sign.
+			signed, err := finalizeMultisig(account, tx, [][][]byte{sig2, {{}}, sig0})
+			if err != nil {
+				t.Fatal(err)
+			}
+			input := signed.Inputs[0]
+			expectedWitness := [][]byte{
+				{},
+				append(derSignature(sig0[0]), 0x01),
+				append(derSignature(sig2[0]), 0x01),
+				unhex(t, multisigTestWitnessScript),
+			}
+			if len(input.Witness) != len(expectedWitness) {
+				t.Fatalf("got %d witness items, expected %d", len(input.Witness), len(expectedWitness))
+			}
+			for i, item := range expectedWitness {
+				if !bytes.Equal(input.Witness[i], item) {
+					t.Errorf("witness item %d: got %x, expected %x", i, input.Witness[i], item)
+				}
+			}
+			if !bytes.Equal(input.ScriptSig, unhex(t, test.scriptSig)) {
+				t.Errorf("got scriptSig %x, expected %s", input.ScriptSig, test.scriptSig)
+			}
+			if _, err := parseRawTx(signed.serialize(true)); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestFinalizeMultisigErrors(t *testing.T) {
+	account := newMultisigTestAccount(t, messages.BTCScriptConfig_Multisig_P2WSH)
+	tx := newMultisigTestTx(t)
+	sig0 := signMultisigTx(t, account, tx, multisigTestKeys[0])
+	sig1 := signMultisigTx(t, account, tx, multisigTestKeys[1])
+	foreign := signMultisigTx(t, account, tx, strings.Repeat("04", 32))
+
+	tests := []struct {
+		name          string
+		signatureSets [][][]byte
+		err           string
+	}{
+		{"too few signatures", [][][]byte{sig0}, "input 0 has 1 of 2 required signatures"},
+		{"same signature twice", [][][]byte{sig0, sig0}, "input 0 has 1 of 2 required signatures"},
+		{"signature of no key", [][][]byte{sig0, foreign, sig1}, "signature 1 of input 0 is invalid"},
+		{
+			"wrong number of signatures",
+			[][][]byte{sig0, {sig1[0], sig1[0]}},
+			"signature set 1 has 2 signatures, expected one per input (1)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := finalizeMultisig(account, tx, test.signatureSets)
+			if err == nil || err.Error() != test.err {
+				t.Errorf("got error %v, expected %q", err, test.err)
+			}
+		})
+	}
+}
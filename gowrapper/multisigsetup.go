@@ -0,0 +1,566 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// multisigSetup describes a multisig wallet as exchanged between wallets in setup files: the
+// Coldcard/Sparrow text format or an output descriptor.
+type multisigSetup struct {
+	name       string
+	threshold  uint32
+	scriptType messages.BTCScriptConfig_Multisig_ScriptType
+	keys       []*multisigSetupKey
+}
+
+// multisigSetupKey is the account-level xpub of a cosigner with its key origin.
+type multisigSetupKey struct {
+	rootFingerprint []byte
+	keypath         []uint32
+	xpub            string
+}
+
+// parseKeypath parses a keypath like "m/48'/0'/0'/2'". The "m/" prefix is optional, and hardened
+// elements can be marked with ', h or H.
+func parseKeypath(keypath string) ([]uint32, error) {
+	errInvalid := fmt.Errorf("invalid keypath: %s", keypath)
+	keypath = strings.TrimPrefix(strings.TrimPrefix(keypath, "m"), "/")
+	if keypath == "" {
+		return []uint32{}, nil
+	}
+	elements := strings.Split(keypath, "/")
+	result := make([]uint32, len(elements))
+	for i, element := range elements {
+		hardened := strings.HasSuffix(element, "'") || strings.HasSuffix(element, "h") ||
+			strings.HasSuffix(element, "H")
+		if hardened {
+			element = element[:len(element)-1]
+		}
+		value, err := strconv.ParseUint(element, 10, 31)
+		if err != nil {
+			return nil, errInvalid
+		}
+		result[i] = uint32(value)
+		if hardened {
+			result[i] += hardenedKeyStart
+		}
+	}
+	return result, nil
+}
+
+// formatKeypath formats a keypath without the "m/" prefix, with hardened elements marked with the
+// given suffix.
+func formatKeypath(keypath []uint32, hardenedSuffix string) string {
+	elements := make([]string, len(keypath))
+	for i, element := range keypath {
+		if element >= hardenedKeyStart {
+			elements[i] = fmt.Sprintf("%d%s", element-hardenedKeyStart, hardenedSuffix)
+		} else {
+			elements[i] = fmt.Sprintf("%d", element)
+		}
+	}
+	return strings.Join(elements, "/")
+}
+
+func parseFingerprint(fingerprint string) ([]byte, error) {
+	decoded, err := hex.DecodeString(fingerprint)
+	if err != nil || len(decoded) != 4 {
+		return nil, fmt.Errorf("invalid root fingerprint: %s", fingerprint)
+	}
+	return decoded, nil
+}
+
+// validate checks the threshold and xpubs of the setup.
+func (setup *multisigSetup) validate() error {
+	xpubs := make([]string, len(setup.keys))
+	for i, key := range setup.keys {
+		xpubs[i] = key.xpub
+	}
+	_, err := newBTCScriptConfigMultisig(setup.threshold, xpubs, 0, setup.scriptType)
+	return err
+}
+
+// parseMultisigSetup parses a multisig setup given as a wsh(sortedmulti(...)) or
+// sh(wsh(sortedmulti(...))) descriptor, or as a Coldcard/Sparrow multisig setup file.
+func parseMultisigSetup(text string) (*multisigSetup, error) {
+	text = strings.TrimSpace(text)
+	var setup *multisigSetup
+	var err error
+	if strings.HasPrefix(text, "wsh(") || strings.HasPrefix(text, "sh(") {
+		setup, err = parseMultisigDescriptor(text)
+	} else {
+		setup, err = parseColdcardMultisigSetup(text)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := setup.validate(); err != nil {
+		return nil, err
+	}
+	return setup, nil
+}
+
+// parseColdcardMultisigSetup parses the multisig setup file format of Coldcard, also used by
+// Sparrow and others:
+//
+//	Name: My wallet
+//	Policy: 2 of 3
+//	Derivation: m/48'/0'/0'/2'
+//	Format: P2WSH
+//
+//	<root fingerprint>: <xpub>
+//	...
+//
+// A Derivation line applies to the keys following it.
+func parseColdcardMultisigSetup(text string) (*multisigSetup, error) {
+	setup := &multisigSetup{scriptType: messages.BTCScriptConfig_Multisig_P2WSH}
+	var derivation []uint32
+	var policyN uint64
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		colon := strings.Index(line, ":")
+		if colon < 0 {
+			return nil, fmt.Errorf("invalid line in multisig setup: %s", line)
+		}
+		label := strings.TrimSpace(line[:colon])
+		value := strings.TrimSpace(line[colon+1:])
+		switch strings.ToLower(label) {
+		case "name":
+			setup.name = value
+		case "policy":
+			parts := strings.Fields(strings.Replace(strings.ToLower(value), "of", " ", 1))
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("invalid policy: %s", value)
+			}
+			threshold, err := strconv.ParseUint(parts[0], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid policy: %s", value)
+			}
+			policyN, err = strconv.ParseUint(parts[1], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid policy: %s", value)
+			}
+			setup.threshold = uint32(threshold)
+		case "derivation":
+			var err error
+			derivation, err = parseKeypath(value)
+			if err != nil {
+				return nil, err
+			}
+		case "format":
+			switch strings.ToUpper(value) {
+			case "P2WSH":
+				setup.scriptType = messages.BTCScriptConfig_Multisig_P2WSH
+			case "P2SH-P2WSH", "P2WSH-P2SH":
+				setup.scriptType = messages.BTCScriptConfig_Multisig_P2WSH_P2SH
+			default:
+				return nil, fmt.Errorf("unsupported multisig format: %s", value)
+			}
+		default:
+			fingerprint, err := parseFingerprint(label)
+			if err != nil {
+				return nil, fmt.Errorf("invalid line in multisig setup: %s", line)
+			}
+			if derivation == nil {
+				return nil, errors.New("missing derivation in multisig setup")
+			}
+			setup.keys = append(setup.keys, &multisigSetupKey{
+				rootFingerprint: fingerprint,
+				keypath:         derivation,
+				xpub:            value,
+			})
+		}
+	}
+	if setup.threshold == 0 {
+		return nil, errors.New("missing policy in multisig setup")
+	}
+	if policyN != uint64(len(setup.keys)) {
+		return nil, fmt.Errorf("policy expects %d keys, got %d", policyN, len(setup.keys))
+	}
+	return setup, nil
+}
+
+// coldcard formats the setup in the Coldcard multisig setup file format.
+func (setup *multisigSetup) coldcard() string {
+	var buf strings.Builder
+	format := "P2WSH"
+	if setup.scriptType == messages.BTCScriptConfig_Multisig_P2WSH_P2SH {
+		format = "P2SH-P2WSH"
+	}
+	fmt.Fprintf(&buf, "Name: %s\n", setup.name)
+	fmt.Fprintf(&buf, "Policy: %d of %d\n", setup.threshold, len(setup.keys))
+	fmt.Fprintf(&buf, "Format: %s\n", format)
+	var derivation []uint32
+	for _, key := range setup.keys {
+		// A Derivation line is only needed when it changes.
+		if derivation == nil || formatKeypath(derivation, "'") != formatKeypath(key.keypath, "'") {
+			derivation = key.keypath
+			fmt.Fprintf(&buf, "\nDerivation: m/%s\n", formatKeypath(derivation, "'"))
+		}
+		fmt.Fprintf(&buf, "%s: %s\n", strings.ToUpper(hex.EncodeToString(key.rootFingerprint)), key.xpub)
+	}
+	return buf.String()
+}
+
+const (
+	descriptorInputCharset    = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	descriptorChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+// descriptorChecksum computes the checksum of an output descriptor, see BIP380.
+func descriptorChecksum(descriptor string) (string, error) {
+	generator := []uint64{0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd}
+	chk := uint64(1)
+	polymod := func(value uint64) {
+		top := chk >> 35
+		chk = (chk&0x7ffffffff)<<5 ^ value
+		for i, g := range generator {
+			if (top>>uint(i))&1 != 0 {
+				chk ^= g
+			}
+		}
+	}
+	var groups []uint64
+	for _, c := range descriptor {
+		position := strings.IndexRune(descriptorInputCharset, c)
+		if position < 0 {
+			return "", fmt.Errorf("invalid character in descriptor: %q", c)
+		}
+		polymod(uint64(position) & 31)
+		groups = append(groups, uint64(position)>>5)
+		if len(groups) == 3 {
+			polymod(groups[0]*9 + groups[1]*3 + groups[2])
+			groups = nil
+		}
+	}
+	switch len(groups) {
+	case 1:
+		polymod(groups[0])
+	case 2:
+		polymod(groups[0]*3 + groups[1])
+	}
+	for i := 0; i < 8; i++ {
+		polymod(0)
+	}
+	chk ^= 1
+	checksum := make([]byte, 8)
+	for i := range checksum {
+		checksum[i] = descriptorChecksumCharset[(chk>>(5*uint(7-i)))&31]
+	}
+	return string(checksum), nil
+}
+
+// parseMultisigDescriptor parses a wsh(sortedmulti(...)) or sh(wsh(sortedmulti(...))) descriptor
+// with key origins, e.g. `wsh(sortedmulti(2,[93f245d7/48'/0'/0'/2']xpub.../0/*,...))#checksum`.
+// The keys can end in /0/*, /1/*, /<0;1>/* or nothing.
+func parseMultisigDescriptor(descriptor string) (*multisigSetup, error) {
+	if hash := strings.LastIndex(descriptor, "#"); hash >= 0 {
+		expected, err := descriptorChecksum(descriptor[:hash])
+		if err != nil {
+			return nil, err
+		}
+		if descriptor[hash+1:] != expected {
+			return nil, errors.New("invalid descriptor checksum")
+		}
+		descriptor = descriptor[:hash]
+	}
+	setup := &multisigSetup{}
+	var inner string
+	switch {
+	case strings.HasPrefix(descriptor, "wsh(sortedmulti(") && strings.HasSuffix(descriptor, "))"):
+		setup.scriptType = messages.BTCScriptConfig_Multisig_P2WSH
+		inner = strings.TrimSuffix(strings.TrimPrefix(descriptor, "wsh(sortedmulti("), "))")
+	case strings.HasPrefix(descriptor, "sh(wsh(sortedmulti(") && strings.HasSuffix(descriptor, ")))"):
+		setup.scriptType = messages.BTCScriptConfig_Multisig_P2WSH_P2SH
+		inner = strings.TrimSuffix(strings.TrimPrefix(descriptor, "sh(wsh(sortedmulti("), ")))")
+	default:
+		return nil, errors.New("unsupported descriptor, expected wsh(sortedmulti(...)) or sh(wsh(sortedmulti(...)))")
+	}
+	args := strings.Split(inner, ",")
+	threshold, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid threshold: %s", args[0])
+	}
+	setup.threshold = uint32(threshold)
+	for _, key := range args[1:] {
+		if !strings.HasPrefix(key, "[") || !strings.Contains(key, "]") {
+			return nil, fmt.Errorf("missing key origin: %s", key)
+		}
+		end := strings.Index(key, "]")
+		origin, xpub := key[1:end], key[end+1:]
+		for _, suffix := range []string{"/0/*", "/1/*", "/<0;1>/*"} {
+			xpub = strings.TrimSuffix(xpub, suffix)
+		}
+		if strings.Contains(xpub, "/") {
+			return nil, fmt.Errorf("unsupported key derivation: %s", key)
+		}
+		// The origin is the root fingerprint, optionally followed by the keypath without "m/".
+		originFingerprint, originKeypath := origin, ""
+		if slash := strings.Index(origin, "/"); slash >= 0 {
+			originFingerprint, originKeypath = origin[:slash], origin[slash+1:]
+			if originKeypath == "" || strings.HasPrefix(originKeypath, "/") ||
+				strings.HasPrefix(originKeypath, "m") {
+				return nil, fmt.Errorf("invalid key origin: %s", origin)
+			}
+		}
+		fingerprint, err := parseFingerprint(originFingerprint)
+		if err != nil {
+			return nil, err
+		}
+		keypath, err := parseKeypath(originKeypath)
+		if err != nil {
+			return nil, err
+		}
+		setup.keys = append(setup.keys, &multisigSetupKey{
+			rootFingerprint: fingerprint,
+			keypath:         keypath,
+			xpub:            xpub,
+		})
+	}
+	return setup, nil
+}
+
+// descriptor formats the setup as a receive address descriptor with checksum.
+func (setup *multisigSetup) descriptor() (string, error) {
+	keys := make([]string, len(setup.keys))
+	for i, key := range setup.keys {
+		origin := hex.EncodeToString(key.rootFingerprint)
+		if len(key.keypath) != 0 {
+			origin += "/" + formatKeypath(key.keypath, "h")
+		}
+		keys[i] = fmt.Sprintf("[%s]%s/0/*", origin, key.xpub)
+	}
+	descriptor := fmt.Sprintf("wsh(sortedmulti(%d,%s))", setup.threshold, strings.Join(keys, ","))
+	if setup.scriptType == messages.BTCScriptConfig_Multisig_P2WSH_P2SH {
+		descriptor = fmt.Sprintf("sh(%s)", descriptor)
+	}
+	checksum, err := descriptorChecksum(descriptor)
+	if err != nil {
+		return "", err
+	}
+	return descriptor + "#" + checksum, nil
+}
+
+// btcMultisigSetup is the JS representation of a multisigSetup.
+type btcMultisigSetup struct {
+	*js.Object
+	Name       string                                       `js:"name"`
+	Threshold  uint32                                       `js:"threshold"`
+	ScriptType messages.BTCScriptConfig_Multisig_ScriptType `js:"scriptType"`
+	Keys       []*btcKeyOriginInfo                          `js:"keys"`
+}
+
+func (setup *btcMultisigSetup) toMultisigSetup() (*multisigSetup, error) {
+	result := &multisigSetup{
+		name:       setup.Name,
+		threshold:  setup.Threshold,
+		scriptType: setup.ScriptType,
+	}
+	for _, key := range setup.Keys {
+		if len(key.RootFingerprint) != 4 {
+			return nil, errors.New("root fingerprint must be 4 bytes")
+		}
+		result.keys = append(result.keys, &multisigSetupKey{
+			rootFingerprint: key.RootFingerprint,
+			keypath:         key.Keypath,
+			xpub:            key.XPub,
+		})
+	}
+	if err := result.validate(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// jsKeypath converts a keypath to a plain JS array, so that it can be used like the keypaths
+// returned by getKeypathFromString().
+func jsKeypath(keypath []uint32) []interface{} {
+	result := make([]interface{}, len(keypath))
+	for i, element := range keypath {
+		result[i] = element
+	}
+	return result
+}
+
+func (setup *multisigSetup) toJS() map[string]interface{} {
+	keys := make([]interface{}, len(setup.keys))
+	for i, key := range setup.keys {
+		keys[i] = map[string]interface{}{
+			"rootFingerprint": key.rootFingerprint,
+			"keypath":         jsKeypath(key.keypath),
+			"xpub":            key.xpub,
+		}
+	}
+	return map[string]interface{}{
+		"name":       setup.name,
+		"threshold":  setup.threshold,
+		"scriptType": setup.scriptType,
+		"keys":       keys,
+	}
+}
+
+// btcParseMultisigSetup parses a Coldcard/Sparrow multisig setup file or a multisig descriptor.
+func btcParseMultisigSetup(text string) (map[string]interface{}, *jsError) {
+	setup, err := parseMultisigSetup(text)
+	if err != nil {
+		return nil, toJSError(err)
+	}
+	return setup.toJS(), nil
+}
+
+// btcFormatMultisigSetup formats a multisig setup as a Coldcard/Sparrow multisig setup file
+// (format "coldcard") or as a descriptor (format "descriptor").
+func btcFormatMultisigSetup(setup *btcMultisigSetup, format string) (string, *jsError) {
+	converted, err := setup.toMultisigSetup()
+	if err != nil {
+		return "", toJSError(err)
+	}
+	switch format {
+	case "coldcard":
+		return converted.coldcard(), nil
+	case "descriptor":
+		descriptor, err := converted.descriptor()
+		return descriptor, toJSError(err)
+	default:
+		return "", toJSError(fmt.Errorf("unknown multisig setup format: %s", format))
+	}
+}
+
+// AsyncBTCMultisigConfigFromSetup converts a multisig setup to the multisig account config used to
+// register the account, display addresses and sign. Our xpub is located by the root fingerprint of
+// the device and checked against the xpub the device derives at the key's keypath.
+func (device *jsDevice) AsyncBTCMultisigConfigFromSetup(
+	done func(map[string]interface{}, *jsError),
+	coin messages.BTCCoin,
+	setup *btcMultisigSetup,
+) {
+	go func() {
+		converted, err := setup.toMultisigSetup()
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		fingerprint, err := device.device.RootFingerprint()
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		ourXPubIndex := -1
+		xpubs := make([]interface{}, len(converted.keys))
+		for i, key := range converted.keys {
+			xpubs[i] = key.xpub
+			if ourXPubIndex != -1 || !bytes.Equal(key.rootFingerprint, fingerprint) {
+				continue
+			}
+			// The fingerprint alone can collide, so make sure the xpub is ours too.
+			ours, err := device.isOurXPub(coin, key.keypath, key.xpub)
+			if err != nil {
+				done(nil, toJSError(err))
+				return
+			}
+			if ours {
+				ourXPubIndex = i
+			}
+		}
+		if ourXPubIndex == -1 {
+			done(nil, toJSError(errors.New("none of the multisig keys belongs to this device")))
+			return
+		}
+		done(map[string]interface{}{
+			"coin":           coin,
+			"keypathAccount": jsKeypath(converted.keys[ourXPubIndex].keypath),
+			"threshold":      converted.threshold,
+			"xpubs":          xpubs,
+			"ourXPubIndex":   ourXPubIndex,
+			"scriptType":     converted.scriptType,
+		}, nil)
+	}()
+}
+
+// AsyncBTCMultisigSetupFromConfig converts a multisig account config to a multisig setup, which can
+// then be exported. rootFingerprints contains the 4 byte root fingerprint of each cosigner, in the
+// order of the xpubs. Our root fingerprint is taken from the device and can be left empty. All
+// cosigners are assumed to use the account keypath of the config.
+func (device *jsDevice) AsyncBTCMultisigSetupFromConfig(
+	done func(map[string]interface{}, *jsError),
+	config *btcMultisigConfig,
+	name string,
+	rootFingerprints [][]byte,
+) {
+	go func() {
+		if len(rootFingerprints) != len(config.XPubs) {
+			done(nil, toJSError(errors.New("expected one root fingerprint per xpub")))
+			return
+		}
+		if config.OurXPubIndex >= uint32(len(config.XPubs)) {
+			done(nil, toJSError(errors.New("ourXPubIndex out of range")))
+			return
+		}
+		fingerprint, err := device.device.RootFingerprint()
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		// Make sure our xpub belongs to the device, so the setup can be imported by cosigners.
+		ours, err := device.isOurXPub(
+			config.Coin, config.KeypathAccount, config.XPubs[config.OurXPubIndex])
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		if !ours {
+			done(nil, toJSError(errors.New("the xpub at ourXPubIndex does not belong to this device")))
+			return
+		}
+		setup := &multisigSetup{
+			name:       name,
+			threshold:  config.Threshold,
+			scriptType: config.ScriptType,
+		}
+		for i, xpub := range config.XPubs {
+			keyFingerprint := rootFingerprints[i]
+			if uint32(i) == config.OurXPubIndex {
+				keyFingerprint = fingerprint
+			}
+			if len(keyFingerprint) != 4 {
+				done(nil, toJSError(fmt.Errorf("root fingerprint %d must be 4 bytes", i)))
+				return
+			}
+			setup.keys = append(setup.keys, &multisigSetupKey{
+				rootFingerprint: keyFingerprint,
+				keypath:         config.KeypathAccount,
+				xpub:            xpub,
+			})
+		}
+		if err := setup.validate(); err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		done(setup.toJS(), nil)
+	}()
+}
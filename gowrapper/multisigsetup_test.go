@@ -0,0 +1,204 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+// Valid xpubs from the BIP32 and BIP86 test vectors.
+const (
+	testXPub1 = "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"
+	testXPub2 = "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ"
+	testXPub3 = "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2afYWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ"
+)
+
+func TestDescriptorChecksum(t *testing.T) {
+	// BIP380 test vector.
+	checksum, err := descriptorChecksum("raw(deadbeef)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checksum != "89f8spxm" {
+		t.Errorf("got checksum %s, expected 89f8spxm", checksum)
+	}
+	if _, err := descriptorChecksum("raw(deadbeef)\n"); err == nil {
+		t.Error("expected an error for an invalid character")
+	}
+}
+
+func TestParseKeypath(t *testing.T) {
+	tests := []struct {
+		keypath  string
+		expected []uint32
+	}{
+		{"m", []uint32{}},
+		{"m/48'/0'/0'/2'", []uint32{48 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart, 2 + hardenedKeyStart}},
+		{"48h/1H/0/5", []uint32{48 + hardenedKeyStart, 1 + hardenedKeyStart, 0, 5}},
+	}
+	for _, test := range tests {
+		keypath, err := parseKeypath(test.keypath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(keypath, test.expected) {
+			t.Errorf("parseKeypath(%q) = %v, expected %v", test.keypath, keypath, test.expected)
+		}
+	}
+	for _, keypath := range []string{"m/a", "m/-1", "m/2147483648", "m/0''"} {
+		if _, err := parseKeypath(keypath); err == nil {
+			t.Errorf("parseKeypath(%q): expected an error", keypath)
+		}
+	}
+	if formatted := formatKeypath([]uint32{48 + hardenedKeyStart, 1 + hardenedKeyStart, 0, 5}, "h"); formatted != "48h/1h/0/5" {
+		t.Errorf("got %s", formatted)
+	}
+}
+
+func TestParseMultisigSetup(t *testing.T) {
+	keypath := []uint32{48 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart, 2 + hardenedKeyStart}
+	expected := &multisigSetup{
+		name:       "My wallet",
+		threshold:  2,
+		scriptType: messages.BTCScriptConfig_Multisig_P2WSH,
+		keys: []*multisigSetupKey{
+			{rootFingerprint: []byte{0x93, 0xf2, 0x45, 0xd7}, keypath: keypath, xpub: testXPub1},
+			{rootFingerprint: []byte{0x11, 0x22, 0x33, 0x44}, keypath: keypath, xpub: testXPub2},
+			{rootFingerprint: []byte{0xaa, 0xbb, 0xcc, 0xdd}, keypath: keypath, xpub: testXPub3},
+		},
+	}
+	coldcard := "# Exported by a wallet\n" +
+		"Name: My wallet\n" +
+		"Policy: 2 of 3\n" +
+		"Derivation: m/48'/0'/0'/2'\n" +
+		"Format: P2WSH\n" +
+		"\n" +
+		"93F245D7: " + testXPub1 + "\n" +
+		"11223344: " + testXPub2 + "\n" +
+		"aabbccdd: " + testXPub3 + "\n"
+	setup, err := parseMultisigSetup(coldcard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkMultisigSetup(t, setup, expected)
+
+	// The formatted setup parses to the same setup, in both formats.
+	setup, err = parseMultisigSetup(expected.coldcard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkMultisigSetup(t, setup, expected)
+
+	descriptor, err := expected.descriptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(descriptor, "wsh(sortedmulti(2,[93f245d7/48h/0h/0h/2h]"+testXPub1+"/0/*,") {
+		t.Errorf("unexpected descriptor: %s", descriptor)
+	}
+	setup, err = parseMultisigSetup(descriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Descriptors have no name.
+	setup.name = expected.name
+	checkMultisigSetup(t, setup, expected)
+
+	wrapped := *expected
+	wrapped.scriptType = messages.BTCScriptConfig_Multisig_P2WSH_P2SH
+	descriptor, err = wrapped.descriptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setup, err = parseMultisigSetup(descriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setup.name = expected.name
+	checkMultisigSetup(t, setup, &wrapped)
+}
+
+func TestParseMultisigDescriptorOriginWithoutKeypath(t *testing.T) {
+	setup, err := parseMultisigSetup(
+		"wsh(sortedmulti(1,[93f245d7]" + testXPub1 + ",[11223344]" + testXPub2 + "))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkMultisigSetup(t, setup, &multisigSetup{
+		threshold:  1,
+		scriptType: messages.BTCScriptConfig_Multisig_P2WSH,
+		keys: []*multisigSetupKey{
+			{rootFingerprint: []byte{0x93, 0xf2, 0x45, 0xd7}, keypath: []uint32{}, xpub: testXPub1},
+			{rootFingerprint: []byte{0x11, 0x22, 0x33, 0x44}, keypath: []uint32{}, xpub: testXPub2},
+		},
+	})
+}
+
+func TestParseMultisigSetupErrors(t *testing.T) {
+	key1 := "[93f245d7/48h/0h/0h/2h]" + testXPub1 + "/0/*"
+	key2 := "[11223344/48h/0h/0h/2h]" + testXPub2 + "/0/*"
+	withChecksum := func(descriptor string) string {
+		checksum, err := descriptorChecksum(descriptor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return descriptor + "#" + checksum
+	}
+	tests := []struct {
+		name string
+		text string
+	}{
+		{"invalid checksum", "wsh(sortedmulti(1," + key1 + "," + key2 + "))#00000000"},
+		{"unsorted multi", withChecksum("wsh(multi(1," + key1 + "," + key2 + "))")},
+		{"taproot", withChecksum("tr(" + key1 + ")")},
+		{"threshold too high", withChecksum("wsh(sortedmulti(3," + key1 + "," + key2 + "))")},
+		{"missing key origin", withChecksum("wsh(sortedmulti(1," + testXPub1 + "," + key2 + "))")},
+		{"invalid xpub", withChecksum("wsh(sortedmulti(1,[93f245d7/48h/0h/0h/2h]xpub123," + key2 + "))")},
+		{"short fingerprint", withChecksum("wsh(sortedmulti(1,[93f245/48h/0h/0h/2h]" + testXPub1 + "," + key2 + "))")},
+		{"non-hex fingerprint", withChecksum("wsh(sortedmulti(1,[93f245zz/48h/0h/0h/2h]" + testXPub1 + "," + key2 + "))")},
+		{"fingerprint without separator", withChecksum("wsh(sortedmulti(1,[93f245d748h/0h/0h/2h]" + testXPub1 + "," + key2 + "))")},
+		{"empty keypath element", withChecksum("wsh(sortedmulti(1,[93f245d7//48h/0h/0h/2h]" + testXPub1 + "," + key2 + "))")},
+		{"empty origin keypath", withChecksum("wsh(sortedmulti(1,[93f245d7/]" + testXPub1 + "," + key2 + "))")},
+		{"origin keypath with m", withChecksum("wsh(sortedmulti(1,[93f245d7/m/48h/0h/0h/2h]" + testXPub1 + "," + key2 + "))")},
+		{"missing policy", "Name: My wallet\nDerivation: m/48'/0'/0'/2'\n\n93F245D7: " + testXPub1 + "\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := parseMultisigSetup(test.text); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func checkMultisigSetup(t *testing.T, setup *multisigSetup, expected *multisigSetup) {
+	t.Helper()
+	if setup.name != expected.name || setup.threshold != expected.threshold ||
+		setup.scriptType != expected.scriptType || len(setup.keys) != len(expected.keys) {
+		t.Fatalf("got %+v, expected %+v", setup, expected)
+	}
+	for i, key := range setup.keys {
+		expectedKey := expected.keys[i]
+		if !bytes.Equal(key.rootFingerprint, expectedKey.rootFingerprint) ||
+			!reflect.DeepEqual(key.keypath, expectedKey.keypath) || key.xpub != expectedKey.xpub {
+			t.Errorf("key %d: got %+v, expected %+v", i, key, expectedKey)
+		}
+	}
+}
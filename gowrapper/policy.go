@@ -0,0 +1,279 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+	"github.com/digitalbitbox/bitbox02-api-go/util/semver"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+type btcKeyOriginInfo struct {
+	*js.Object
+	RootFingerprint []byte   `js:"rootFingerprint"`
+	Keypath         []uint32 `js:"keypath"`
+	XPub            string   `js:"xpub"`
+}
+
+// newKeyOriginInfo converts a policy key, given its root fingerprint, its keypath and its xpub in
+// the usual base58 encoding.
+func newKeyOriginInfo(
+	rootFingerprint []byte, keypath []uint32, xpub string) (*messages.KeyOriginInfo, error) {
+	if len(rootFingerprint) != 4 {
+		return nil, errors.New("invalid root fingerprint")
+	}
+	convertedXPub, err := firmware.NewXPub(xpub)
+	if err != nil {
+		return nil, err
+	}
+	return &messages.KeyOriginInfo{
+		RootFingerprint: rootFingerprint,
+		Keypath:         keypath,
+		Xpub:            convertedXPub,
+	}, nil
+}
+
+// newBTCScriptConfigPolicy is the policy equivalent of firmware.NewBTCScriptConfigMultisig.
+func newBTCScriptConfigPolicy(
+	policy string, keys []*messages.KeyOriginInfo) *messages.BTCScriptConfig {
+	return &messages.BTCScriptConfig{
+		Config: &messages.BTCScriptConfig_Policy_{
+			Policy: &messages.BTCScriptConfig_Policy{
+				Policy: policy,
+				Keys:   keys,
+			},
+		},
+	}
+}
+
+// ourPolicyKeypath returns the keypath of the policy key belonging to the device that the inputs
+// are spent with. Keys are matched to the device by the root fingerprint and the xpub at the key's
+// keypath. The device may hold several keys of a policy, e.g. the primary and the recovery key of
+// an inheritance policy in different accounts, so the key is picked by the keypaths of the inputs,
+// which are the key's keypath concatenated with [change, address].
+func (device *jsDevice) ourPolicyKeypath(
+	coin messages.BTCCoin, keys []*btcKeyOriginInfo, inputs []*firmware.BTCTxInput,
+) ([]uint32, error) {
+	fingerprint, err := device.device.RootFingerprint()
+	if err != nil {
+		return nil, err
+	}
+	var ourKeypaths [][]uint32
+	for _, key := range keys {
+		if !bytes.Equal(key.RootFingerprint, fingerprint) {
+			continue
+		}
+		ours, err := device.isOurXPub(coin, key.Keypath, key.XPub)
+		if err != nil {
+			return nil, err
+		}
+		if ours {
+			ourKeypaths = append(ourKeypaths, key.Keypath)
+		}
+	}
+	if len(ourKeypaths) == 0 {
+		return nil, errors.New("none of the policy keys belongs to this device")
+	}
+	if len(inputs) == 0 {
+		return ourKeypaths[0], nil
+	}
+	for _, keypath := range ourKeypaths {
+		if isPolicyKeypath(keypath, inputs[0].Input.Keypath) {
+			for _, input := range inputs[1:] {
+				if !isPolicyKeypath(keypath, input.Input.Keypath) {
+					return nil, errors.New("the inputs are not spent with the same policy key")
+				}
+			}
+			return keypath, nil
+		}
+	}
+	return nil, errors.New("the input keypaths do not belong to any policy key of this device")
+}
+
+// isPolicyKeypath checks that keypath is the key's keypath concatenated with [change, address].
+func isPolicyKeypath(keyKeypath []uint32, keypath []uint32) bool {
+	if len(keypath) != len(keyKeypath)+2 {
+		return false
+	}
+	for i, element := range keyKeypath {
+		if keypath[i] != element {
+			return false
+		}
+	}
+	return true
+}
+
+// btcPolicy is a wallet policy, e.g. "wsh(and_v(v:pk(@0/**),older(12960)))", with the keys
+// referenced by @0, @1, etc.
+type btcPolicy struct {
+	*js.Object
+	Policy string              `js:"policy"`
+	Keys   []*btcKeyOriginInfo `js:"keys"`
+}
+
+func (policy *btcPolicy) toScriptConfig() (*messages.BTCScriptConfig, error) {
+	keys := make([]*messages.KeyOriginInfo, len(policy.Keys))
+	for i, key := range policy.Keys {
+		var err error
+		keys[i], err = newKeyOriginInfo(key.RootFingerprint, key.Keypath, key.XPub)
+		if err != nil {
+			return nil, fmt.Errorf("policy key %d: %v", i, err)
+		}
+	}
+	return newBTCScriptConfigPolicy(policy.Policy, keys), nil
+}
+
+// btcPolicyAccount is a wallet policy account, the policy equivalent of btcMultisigConfig.
+type btcPolicyAccount struct {
+	*js.Object
+	Coin   messages.BTCCoin    `js:"coin"`
+	Policy string              `js:"policy"`
+	Keys   []*btcKeyOriginInfo `js:"keys"`
+}
+
+// toScriptConfig converts the account to a script config. The account object has the same "policy"
+// and "keys" fields as btcPolicy, so it is converted as one.
+func (account *btcPolicyAccount) toScriptConfig() (*messages.BTCScriptConfig, error) {
+	return (&btcPolicy{Object: account.Object}).toScriptConfig()
+}
+
+// checkPolicyVersion checks that a device with the given firmware version supports the policy.
+// Taproot policies (tr(...)) need a newer firmware than other policies, which is only checked here,
+// not in firmware.BTCSign.
+func checkPolicyVersion(version *semver.SemVer, policy string) error {
+	if !version.AtLeast(semver.NewSemVer(9, 15, 0)) {
+		return firmware.UnsupportedError("9.15.0")
+	}
+	if firmware.IsTaprootPolicy(policy) && !version.AtLeast(semver.NewSemVer(9, 21, 0)) {
+		return firmware.UnsupportedError("9.21.0")
+	}
+	return nil
+}
+
+// checkPolicySupported checks that the connected device supports the policy.
+func (device *jsDevice) checkPolicySupported(policy string) error {
+	return checkPolicyVersion(device.device.Version(), policy)
+}
+
+func (device *jsDevice) AsyncBTCIsPolicyRegistered(
+	done func(bool, *jsError),
+	account *btcPolicyAccount,
+) {
+	go func() {
+		if err := device.checkPolicySupported(account.Policy); err != nil {
+			done(false, toJSError(err))
+			return
+		}
+		conf, err := account.toScriptConfig()
+		if err != nil {
+			done(false, toJSError(err))
+			return
+		}
+		// Policies are registered without a keypath.
+		result, err := device.device.BTCIsScriptConfigRegistered(account.Coin, conf, nil)
+		done(result, toJSError(err))
+	}()
+}
+
+func (device *jsDevice) AsyncBTCRegisterPolicy(
+	done func(*jsError),
+	account *btcPolicyAccount,
+	name string) {
+	go func() {
+		if err := device.checkPolicySupported(account.Policy); err != nil {
+			done(toJSError(err))
+			return
+		}
+		conf, err := account.toScriptConfig()
+		if err != nil {
+			done(toJSError(err))
+			return
+		}
+		done(toJSError(device.device.BTCRegisterScriptConfig(account.Coin, conf, nil, name)))
+	}()
+}
+
+func (device *jsDevice) AsyncBTCAddressPolicy(
+	done func(string, *jsError),
+	account *btcPolicyAccount,
+	keypath []uint32,
+	display bool) {
+	go func() {
+		if err := device.checkPolicySupported(account.Policy); err != nil {
+			done("", toJSError(err))
+			return
+		}
+		conf, err := account.toScriptConfig()
+		if err != nil {
+			done("", toJSError(err))
+			return
+		}
+		address, err := device.device.BTCAddress(account.Coin, keypath, conf, display)
+		done(address, toJSError(err))
+	}()
+}
+
+// AsyncBTCSignPolicy signs a transaction spending from a policy account. Unlike the other signing
+// methods, the signatures are returned without verifying them (see involvesPolicy).
+func (device *jsDevice) AsyncBTCSignPolicy(
+	done func([][]byte, *jsError),
+	account *btcPolicyAccount,
+	inputs []*btcSignInputRequest,
+	outputs []*btcSignOutputRequest,
+	version uint32,
+	locktime uint32,
+) {
+	go func() {
+		if err := device.checkPolicySupported(account.Policy); err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		conf, err := account.toScriptConfig()
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		theInputs, theOutputs, err := convertInputsAndOutputs(account.Coin, inputs, outputs)
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		keypath, err := device.ourPolicyKeypath(account.Coin, account.Keys, theInputs)
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		signatures, err := device.device.BTCSign(
+			account.Coin,
+			[]*messages.BTCScriptConfigWithKeypath{{
+				ScriptConfig: conf,
+				Keypath:      keypath,
+			}},
+			&firmware.BTCTx{
+				Version:  version,
+				Inputs:   theInputs,
+				Outputs:  theOutputs,
+				Locktime: locktime,
+			},
+			messages.BTCSignInitRequest_DEFAULT,
+		)
+		done(signatures, toJSError(err))
+	}()
+}
@@ -0,0 +1,124 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+	"github.com/digitalbitbox/bitbox02-api-go/util/semver"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewKeyOriginInfo(t *testing.T) {
+	fingerprint := []byte{0xf5, 0x4e, 0x00, 0x46}
+	keypath := []uint32{
+		48 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart, 2 + hardenedKeyStart}
+	key, err := newKeyOriginInfo(fingerprint, keypath, testXPub1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := firmware.NewXPub(testXPub1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &messages.KeyOriginInfo{
+		RootFingerprint: fingerprint,
+		Keypath:         keypath,
+		Xpub:            xpub,
+	}
+	if !proto.Equal(key, expected) {
+		t.Errorf("got %v, expected %v", key, expected)
+	}
+	if !bytes.Equal(key.Xpub.ChainCode, xpub.ChainCode) || len(key.Xpub.PublicKey) != 33 {
+		t.Errorf("xpub not converted: %v", key.Xpub)
+	}
+
+	tests := []struct {
+		name        string
+		fingerprint []byte
+		xpub        string
+	}{
+		{"short fingerprint", fingerprint[:3], testXPub1},
+		{"long fingerprint", append(append([]byte{}, fingerprint...), 0), testXPub1},
+		{"no fingerprint", nil, testXPub1},
+		{"empty xpub", fingerprint, ""},
+		{"bad checksum", fingerprint, testXPub1[:len(testXPub1)-1] + "x"},
+		{"truncated xpub", fingerprint, testXPub1[:len(testXPub1)-10]},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := newKeyOriginInfo(test.fingerprint, keypath, test.xpub); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestNewBTCScriptConfigPolicy(t *testing.T) {
+	keys := make([]*messages.KeyOriginInfo, 2)
+	for i, xpub := range []string{testXPub1, testXPub2} {
+		var err error
+		keys[i], err = newKeyOriginInfo(
+			[]byte{1, 2, 3, byte(i)}, []uint32{48 + hardenedKeyStart}, xpub)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	const policy = "wsh(or_d(pk(@0/**),and_v(v:pkh(@1/**),older(12960))))"
+	scriptConfig := newBTCScriptConfigPolicy(policy, keys)
+	if scriptConfig.GetPolicy().Policy != policy {
+		t.Errorf("got policy %q", scriptConfig.GetPolicy().Policy)
+	}
+	if len(scriptConfig.GetPolicy().Keys) != 2 ||
+		!proto.Equal(scriptConfig.GetPolicy().Keys[1], keys[1]) {
+		t.Errorf("got keys %v", scriptConfig.GetPolicy().Keys)
+	}
+}
+
+func TestCheckPolicyVersion(t *testing.T) {
+	const (
+		wshPolicy = "wsh(and_v(v:pk(@0/**),older(12960)))"
+		trPolicy  = " tr(@0/**,pk(@1/**))"
+	)
+	tests := []struct {
+		version     *semver.SemVer
+		policy      string
+		unsupported string
+	}{
+		{semver.NewSemVer(9, 14, 1), wshPolicy, "9.15.0"},
+		{semver.NewSemVer(9, 14, 1), trPolicy, "9.15.0"},
+		{semver.NewSemVer(9, 15, 0), wshPolicy, ""},
+		{semver.NewSemVer(9, 15, 0), trPolicy, "9.21.0"},
+		{semver.NewSemVer(9, 20, 2), trPolicy, "9.21.0"},
+		{semver.NewSemVer(9, 21, 0), trPolicy, ""},
+		{semver.NewSemVer(9, 21, 0), wshPolicy, ""},
+	}
+	for _, test := range tests {
+		err := checkPolicyVersion(test.version, test.policy)
+		if test.unsupported == "" {
+			if err != nil {
+				t.Errorf("%s, %q: unexpected error %v", test.version, test.policy, err)
+			}
+			continue
+		}
+		if err != firmware.UnsupportedError(test.unsupported) {
+			t.Errorf("%s, %q: expected unsupported from %s, got %v",
+				test.version, test.policy, test.unsupported, err)
+		}
+	}
+}
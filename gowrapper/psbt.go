@@ -0,0 +1,468 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// PSBT key types, see https://github.com/bitcoin/bips/blob/master/bip-0174.mediawiki and
+// https://github.com/bitcoin/bips/blob/master/bip-0371.mediawiki.
+const (
+	psbtGlobalUnsignedTx = 0x00
+	psbtGlobalXPub       = 0x01
+	psbtGlobalVersion    = 0xfb
+
+	psbtInNonWitnessUTXO     = 0x00
+	psbtInWitnessUTXO        = 0x01
+	psbtInPartialSig         = 0x02
+	psbtInSighashType        = 0x03
+	psbtInRedeemScript       = 0x04
+	psbtInWitnessScript      = 0x05
+	psbtInBIP32Derivation    = 0x06
+	psbtInFinalScriptSig     = 0x07
+	psbtInFinalScriptWitness = 0x08
+	psbtInTapKeySig          = 0x13
+	psbtInTapScriptSig       = 0x14
+	psbtInTapLeafScript      = 0x15
+	psbtInTapBIP32Derivation = 0x16
+	psbtInTapInternalKey     = 0x17
+	psbtInTapMerkleRoot      = 0x18
+
+	psbtOutRedeemScript       = 0x00
+	psbtOutWitnessScript      = 0x01
+	psbtOutBIP32Derivation    = 0x02
+	psbtOutTapBIP32Derivation = 0x07
+)
+
+var psbtMagic = []byte("psbt\xff")
+
+// psbtKV is one key-value pair of a PSBT map. The first byte of the key is the key type.
+type psbtKV struct {
+	key   []byte
+	value []byte
+}
+
+// psbtMap is a PSBT map. The order of the entries is preserved, so that fields unknown to us are
+// passed through unchanged.
+type psbtMap []*psbtKV
+
+// get returns the value of the entry with the given key, or nil.
+func (m psbtMap) get(key ...byte) []byte {
+	for _, kv := range m {
+		if bytes.Equal(kv.key, key) {
+			return kv.value
+		}
+	}
+	return nil
+}
+
+// getAll returns all entries of the given key type.
+func (m psbtMap) getAll(keyType byte) []*psbtKV {
+	var result []*psbtKV
+	for _, kv := range m {
+		if kv.key[0] == keyType {
+			result = append(result, kv)
+		}
+	}
+	return result
+}
+
+// set adds an entry, replacing the existing one with the same key.
+func (m *psbtMap) set(key []byte, value []byte) {
+	for _, kv := range *m {
+		if bytes.Equal(kv.key, key) {
+			kv.value = value
+			return
+		}
+	}
+	*m = append(*m, &psbtKV{key: key, value: value})
+}
+
+func readPSBTMap(r *txReader) (psbtMap, error) {
+	var m psbtMap
+	seen := map[string]bool{}
+	for {
+		key, err := r.readVarBytes()
+		if err != nil {
+			return nil, err
+		}
+		if len(key) == 0 {
+			return m, nil
+		}
+		if seen[string(key)] {
+			return nil, fmt.Errorf("duplicate key %x", key)
+		}
+		seen[string(key)] = true
+		value, err := r.readVarBytes()
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, &psbtKV{key: key, value: value})
+	}
+}
+
+func (m psbtMap) serialize(buf *bytes.Buffer) {
+	for _, kv := range m {
+		writeVarBytes(buf, kv.key)
+		writeVarBytes(buf, kv.value)
+	}
+	buf.WriteByte(0x00)
+}
+
+// psbt is a version 0 partially signed Bitcoin transaction.
+type psbt struct {
+	global  psbtMap
+	inputs  []psbtMap
+	outputs []psbtMap
+	// tx is the unsigned transaction of the global map.
+	tx *rawTx
+}
+
+// parsePSBT parses a base64 encoded PSBT.
+func parsePSBT(psbtBase64 string) (*psbt, error) {
+	raw, err := base64.StdEncoding.DecodeString(psbtBase64)
+	if err != nil {
+		return nil, errors.New("PSBT is not valid base64")
+	}
+	p, err := readPSBT(raw)
+	if err != nil {
+		return nil, errors.New("could not parse PSBT: " + err.Error())
+	}
+	return p, nil
+}
+
+func readPSBT(raw []byte) (*psbt, error) {
+	if !bytes.HasPrefix(raw, psbtMagic) {
+		return nil, errors.New("invalid magic")
+	}
+	r := newTxReader(raw[len(psbtMagic):])
+	p := &psbt{}
+	var err error
+	if p.global, err = readPSBTMap(r); err != nil {
+		return nil, err
+	}
+	if version := p.global.get(psbtGlobalVersion); version != nil {
+		if len(version) != 4 || binary.LittleEndian.Uint32(version) != 0 {
+			return nil, errors.New("only PSBT version 0 is supported")
+		}
+	}
+	unsignedTx := p.global.get(psbtGlobalUnsignedTx)
+	if unsignedTx == nil {
+		return nil, errors.New("missing unsigned transaction")
+	}
+	if p.tx, err = parseRawTx(unsignedTx); err != nil {
+		return nil, err
+	}
+	for _, input := range p.tx.Inputs {
+		if len(input.ScriptSig) != 0 || len(input.Witness) != 0 {
+			return nil, errors.New("unsigned transaction must not contain signatures")
+		}
+	}
+	p.inputs = make([]psbtMap, len(p.tx.Inputs))
+	for i := range p.inputs {
+		if p.inputs[i], err = readPSBTMap(r); err != nil {
+			return nil, err
+		}
+	}
+	p.outputs = make([]psbtMap, len(p.tx.Outputs))
+	for i := range p.outputs {
+		if p.outputs[i], err = readPSBTMap(r); err != nil {
+			return nil, err
+		}
+	}
+	if r.Len() != 0 {
+		return nil, errors.New("unexpected trailing data")
+	}
+	return p, nil
+}
+
+func (p *psbt) serialize() []byte {
+	var buf bytes.Buffer
+	buf.Write(psbtMagic)
+	p.global.serialize(&buf)
+	for _, input := range p.inputs {
+		input.serialize(&buf)
+	}
+	for _, output := range p.outputs {
+		output.serialize(&buf)
+	}
+	return buf.Bytes()
+}
+
+// base64 returns the base64 encoded PSBT.
+func (p *psbt) base64() string {
+	return base64.StdEncoding.EncodeToString(p.serialize())
+}
+
+// inputUTXO returns the output spent by the input at the given index, and the full previous
+// transaction if it is included in the PSBT.
+func (p *psbt) inputUTXO(index int) (*rawTxOut, *rawTx, error) {
+	txIn := p.tx.Inputs[index]
+	if nonWitnessUTXO := p.inputs[index].get(psbtInNonWitnessUTXO); nonWitnessUTXO != nil {
+		prevTx, err := parseRawTx(nonWitnessUTXO)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !bytes.Equal(prevTx.txid(), txIn.PrevOutHash) {
+			return nil, nil, errors.New("previous transaction does not match the input")
+		}
+		if int(txIn.PrevOutIndex) >= len(prevTx.Outputs) {
+			return nil, nil, errors.New("previous output index out of range")
+		}
+		return prevTx.Outputs[txIn.PrevOutIndex], prevTx, nil
+	}
+	if witnessUTXO := p.inputs[index].get(psbtInWitnessUTXO); witnessUTXO != nil {
+		r := newTxReader(witnessUTXO)
+		utxo, err := readTxOut(r)
+		if err != nil || r.Len() != 0 {
+			return nil, nil, errors.New("invalid witness UTXO")
+		}
+		return utxo, nil, nil
+	}
+	return nil, nil, errors.New("missing UTXO")
+}
+
+// psbtKeyOrigin is the value of a BIP32 derivation field.
+type psbtKeyOrigin struct {
+	fingerprint []byte
+	keypath     []uint32
+	// leafHashes are the taproot leaves the key is used in. Only set for taproot derivations.
+	leafHashes [][]byte
+}
+
+func parseKeyOrigin(value []byte) (*psbtKeyOrigin, error) {
+	if len(value) < 4 || len(value)%4 != 0 {
+		return nil, errors.New("invalid key origin")
+	}
+	keypath := make([]uint32, len(value)/4-1)
+	for i := range keypath {
+		keypath[i] = binary.LittleEndian.Uint32(value[4+4*i:])
+	}
+	return &psbtKeyOrigin{fingerprint: value[:4], keypath: keypath}, nil
+}
+
+func parseTapKeyOrigin(value []byte) (*psbtKeyOrigin, error) {
+	r := newTxReader(value)
+	numHashes, err := r.readCompactSize()
+	if err != nil || numHashes > uint64(r.Len())/32 {
+		return nil, errors.New("invalid taproot key origin")
+	}
+	leafHashes := make([][]byte, numHashes)
+	for i := range leafHashes {
+		if leafHashes[i], err = r.readBytes(32); err != nil {
+			return nil, err
+		}
+	}
+	origin, err := parseKeyOrigin(value[len(value)-r.Len():])
+	if err != nil {
+		return nil, err
+	}
+	origin.leafHashes = leafHashes
+	return origin, nil
+}
+
+// ourKey returns the public key and its key origin of the derivation entries of the given type
+// which belong to the root fingerprint, or nil if there is none. Of the taproot derivations, the
+// one without leaf hashes is preferred, as it is the internal key used for key path spends. A key
+// used in script leaves is only returned if there is no such entry.
+func (m psbtMap) ourKey(keyType byte, fingerprint []byte) ([]byte, *psbtKeyOrigin, error) {
+	var leafKey []byte
+	var leafOrigin *psbtKeyOrigin
+	for _, kv := range m.getAll(keyType) {
+		var origin *psbtKeyOrigin
+		var err error
+		switch keyType {
+		case psbtInTapBIP32Derivation, psbtOutTapBIP32Derivation:
+			origin, err = parseTapKeyOrigin(kv.value)
+		default:
+			origin, err = parseKeyOrigin(kv.value)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if !bytes.Equal(origin.fingerprint, fingerprint) {
+			continue
+		}
+		if len(origin.leafHashes) == 0 {
+			return kv.key[1:], origin, nil
+		}
+		if leafOrigin == nil {
+			leafKey, leafOrigin = kv.key[1:], origin
+		}
+	}
+	return leafKey, leafOrigin, nil
+}
+
+func serializeWitness(witness [][]byte) []byte {
+	var buf bytes.Buffer
+	writeCompactSize(&buf, uint64(len(witness)))
+	for _, item := range witness {
+		writeVarBytes(&buf, item)
+	}
+	return buf.Bytes()
+}
+
+func (p *psbt) inputFinalized(index int) bool {
+	input := p.inputs[index]
+	return input.get(psbtInFinalScriptSig) != nil || input.get(psbtInFinalScriptWitness) != nil
+}
+
+// finalizeInput sets the final scriptSig and witness of an input from its partial signatures,
+// see the Input Finalizer role in BIP174. Supported are P2WPKH, P2WPKH-P2SH, P2TR key path and
+// P2WSH multisig inputs.
+func (p *psbt) finalizeInput(index int) error {
+	if p.inputFinalized(index) {
+		return nil
+	}
+	input := p.inputs[index]
+	utxo, _, err := p.inputUTXO(index)
+	if err != nil {
+		return err
+	}
+	pkScript := utxo.PkScript
+	var scriptSig []byte
+	if isP2SH(pkScript) {
+		redeemScript := input.get(psbtInRedeemScript)
+		if redeemScript == nil {
+			return errors.New("missing redeem script")
+		}
+		if !bytes.Equal(hash160(redeemScript), pkScript[2:22]) {
+			return errors.New("redeem script does not match the previous output")
+		}
+		scriptSig = pushData(redeemScript)
+		pkScript = redeemScript
+	}
+	var witness [][]byte
+	switch {
+	case isP2WPKH(pkScript):
+		partialSigs := input.getAll(psbtInPartialSig)
+		if len(partialSigs) != 1 {
+			return errors.New("expected one signature")
+		}
+		witness, err = p2wpkhWitness(pkScript, partialSigs[0].value, partialSigs[0].key[1:])
+		if err != nil {
+			return err
+		}
+	case isP2WSH(pkScript):
+		witnessScript := input.get(psbtInWitnessScript)
+		if witnessScript == nil {
+			return errors.New("missing witness script")
+		}
+		scriptHash := sha256.Sum256(witnessScript)
+		if !bytes.Equal(scriptHash[:], pkScript[2:]) {
+			return errors.New("witness script does not match the previous output")
+		}
+		threshold, pubkeys, err := parseMultisigScript(witnessScript)
+		if err != nil {
+			return err
+		}
+		// Empty item for the off-by-one bug of OP_CHECKMULTISIG, followed by the signatures in the
+		// order of the public keys in the script.
+		witness = [][]byte{{}}
+		for _, pubkey := range pubkeys {
+			if len(witness)-1 == threshold {
+				break
+			}
+			if sig := input.get(append([]byte{psbtInPartialSig}, pubkey...)...); sig != nil {
+				witness = append(witness, sig)
+			}
+		}
+		if len(witness)-1 != threshold {
+			return fmt.Errorf("expected %d signatures, got %d", threshold, len(witness)-1)
+		}
+		witness = append(witness, witnessScript)
+	case isP2TR(pkScript) && scriptSig == nil:
+		sig := input.get(psbtInTapKeySig)
+		if sig == nil {
+			return errors.New("missing taproot key path signature")
+		}
+		witness = [][]byte{sig}
+	default:
+		return errors.New("unsupported input script")
+	}
+
+	// All fields except for the UTXOs and unknown fields are cleared after finalizing.
+	var finalized psbtMap
+	for _, kv := range input {
+		switch kv.key[0] {
+		case psbtInNonWitnessUTXO, psbtInWitnessUTXO:
+			finalized = append(finalized, kv)
+		case psbtInPartialSig, psbtInSighashType, psbtInRedeemScript, psbtInWitnessScript,
+			psbtInBIP32Derivation, psbtInTapKeySig, psbtInTapScriptSig, psbtInTapLeafScript,
+			psbtInTapBIP32Derivation, psbtInTapInternalKey, psbtInTapMerkleRoot:
+		default:
+			finalized = append(finalized, kv)
+		}
+	}
+	if scriptSig != nil {
+		finalized.set([]byte{psbtInFinalScriptSig}, scriptSig)
+	}
+	finalized.set([]byte{psbtInFinalScriptWitness}, serializeWitness(witness))
+	p.inputs[index] = finalized
+	return nil
+}
+
+// finalize finalizes all inputs.
+func (p *psbt) finalize() error {
+	for i := range p.inputs {
+		if err := p.finalizeInput(i); err != nil {
+			return fmt.Errorf("input %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// extract returns the signed transaction of a finalized PSBT, see the Transaction Extractor role
+// in BIP174.
+func (p *psbt) extract() (*rawTx, error) {
+	tx := &rawTx{
+		Version:  p.tx.Version,
+		Inputs:   make([]*rawTxIn, len(p.tx.Inputs)),
+		Outputs:  p.tx.Outputs,
+		Locktime: p.tx.Locktime,
+	}
+	for i, txIn := range p.tx.Inputs {
+		if !p.inputFinalized(i) {
+			return nil, fmt.Errorf("input %d is not finalized", i)
+		}
+		input := &rawTxIn{
+			PrevOutHash:  txIn.PrevOutHash,
+			PrevOutIndex: txIn.PrevOutIndex,
+			ScriptSig:    p.inputs[i].get(psbtInFinalScriptSig),
+			Sequence:     txIn.Sequence,
+		}
+		if finalWitness := p.inputs[i].get(psbtInFinalScriptWitness); finalWitness != nil {
+			r := newTxReader(finalWitness)
+			numItems, err := r.readCompactSize()
+			if err != nil || numItems > uint64(r.Len()) {
+				return nil, fmt.Errorf("input %d: invalid final witness", i)
+			}
+			input.Witness = make([][]byte, numItems)
+			for j := range input.Witness {
+				if input.Witness[j], err = r.readVarBytes(); err != nil {
+					return nil, fmt.Errorf("input %d: invalid final witness", i)
+				}
+			}
+		}
+		tx.Inputs[i] = input
+	}
+	return tx, nil
+}
@@ -0,0 +1,536 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+	"github.com/gopherjs/gopherjs/js"
+	"google.golang.org/protobuf/proto"
+)
+
+// scriptConfigList collects the script configs of a transaction, so that inputs and outputs of the
+// same account reference the same script config.
+type scriptConfigList []*messages.BTCScriptConfigWithKeypath
+
+// index returns the index of the given script config, adding it if it is not in the list yet.
+func (list *scriptConfigList) index(
+	scriptConfig *messages.BTCScriptConfig, keypath []uint32) uint32 {
+	config := &messages.BTCScriptConfigWithKeypath{
+		ScriptConfig: scriptConfig,
+		Keypath:      keypath,
+	}
+	for i, existing := range *list {
+		if proto.Equal(existing, config) {
+			return uint32(i)
+		}
+	}
+	*list = append(*list, config)
+	return uint32(len(*list) - 1)
+}
+
+// psbtInputSigner holds what is needed to add the device signature of an input to the PSBT.
+type psbtInputSigner struct {
+	taproot bool
+	// pubkey is the 33 byte compressed public key for ECDSA inputs, and the 32 byte x-only public
+	// key for taproot inputs.
+	pubkey []byte
+}
+
+// ourPSBTInput matches an input against our root fingerprint and returns its script type, keypath
+// and the key signing it.
+func ourPSBTInput(
+	p *psbt, index int, fingerprint []byte, utxo *rawTxOut,
+) (messages.BTCScriptConfig_SimpleType, []uint32, *psbtInputSigner, error) {
+	input := p.inputs[index]
+	var simpleType messages.BTCScriptConfig_SimpleType
+	var pubkey []byte
+	var origin *psbtKeyOrigin
+	var err error
+	switch {
+	case isP2WPKH(utxo.PkScript):
+		simpleType = messages.BTCScriptConfig_P2WPKH
+		pubkey, origin, err = input.ourKey(psbtInBIP32Derivation, fingerprint)
+	case isP2SH(utxo.PkScript) && isP2WPKH(input.get(psbtInRedeemScript)):
+		simpleType = messages.BTCScriptConfig_P2WPKH_P2SH
+		pubkey, origin, err = input.ourKey(psbtInBIP32Derivation, fingerprint)
+	case isP2TR(utxo.PkScript):
+		simpleType = messages.BTCScriptConfig_P2TR
+		pubkey, origin, err = input.ourKey(psbtInTapBIP32Derivation, fingerprint)
+		if err == nil && origin != nil && len(origin.leafHashes) != 0 {
+			return 0, nil, nil, errors.New("taproot script path spends are not supported")
+		}
+	default:
+		return 0, nil, nil, errors.New("unsupported input script")
+	}
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if origin == nil {
+		return 0, nil, nil, errors.New("input does not belong to this device")
+	}
+	taproot := simpleType == messages.BTCScriptConfig_P2TR
+	if (taproot && len(pubkey) != 32) || (!taproot && len(pubkey) != 33) {
+		return 0, nil, nil, errors.New("invalid public key")
+	}
+	if err := checkPSBTSighashType(input, taproot); err != nil {
+		return 0, nil, nil, err
+	}
+	return simpleType, origin.keypath, &psbtInputSigner{taproot: taproot, pubkey: pubkey}, nil
+}
+
+// checkPSBTSighashType checks that the sighash type of an input, if set, is the one the device
+// signs with: SIGHASH_DEFAULT for taproot, SIGHASH_ALL otherwise.
+func checkPSBTSighashType(input psbtMap, taproot bool) error {
+	sighashType := input.get(psbtInSighashType)
+	if sighashType == nil {
+		return nil
+	}
+	if len(sighashType) != 4 {
+		return errors.New("invalid sighash type")
+	}
+	expected := []byte{1, 0, 0, 0}
+	if taproot {
+		expected = []byte{0, 0, 0, 0}
+	}
+	if !bytes.Equal(sighashType, expected) {
+		return errors.New("unsupported sighash type")
+	}
+	return nil
+}
+
+// psbtMultisigAccount is a multisig account registered on the device. Inputs and change outputs
+// of a PSBT are matched against it by their script.
+type psbtMultisigAccount struct {
+	keypathAccount []uint32
+	scriptConfig   *messages.BTCScriptConfig
+	account        *multisigAccount
+}
+
+// isPSBTMultisig returns true if the pubkey script, with the redeem script for P2SH, is P2WSH or
+// P2WSH-P2SH.
+func isPSBTMultisig(pkScript []byte, redeemScript []byte) bool {
+	return isP2WSH(pkScript) || (isP2SH(pkScript) && isP2WSH(redeemScript))
+}
+
+// matchPSBTMultisig returns the multisig account whose script at the given address-level keypath is
+// pkScript. If the PSBT contains the redeem script or witness script, they must match the account
+// as well. Returns nil if no account matches.
+func matchPSBTMultisig(
+	accounts []*psbtMultisigAccount,
+	keypath []uint32,
+	pkScript []byte,
+	redeemScript []byte,
+	witnessScript []byte,
+) (*psbtMultisigAccount, error) {
+	for _, multisig := range accounts {
+		if len(keypath) != len(multisig.keypathAccount)+2 {
+			continue
+		}
+		// Deriving fails if the keypath is not below the account, which is not a match either.
+		expectedPkScript, err := multisig.account.pkScript(keypath)
+		if err != nil || !bytes.Equal(pkScript, expectedPkScript) {
+			continue
+		}
+		expectedWitnessScript, _, err := multisig.account.witnessScript(keypath)
+		if err != nil {
+			return nil, err
+		}
+		if witnessScript != nil && !bytes.Equal(witnessScript, expectedWitnessScript) {
+			return nil, errors.New("witness script does not match the keypath")
+		}
+		if multisig.account.scriptType == messages.BTCScriptConfig_Multisig_P2WSH_P2SH {
+			expectedRedeemScript, err := p2wshPkScript(expectedWitnessScript)
+			if err != nil {
+				return nil, err
+			}
+			if !bytes.Equal(redeemScript, expectedRedeemScript) {
+				return nil, errors.New("redeem script does not match the keypath")
+			}
+		}
+		return multisig, nil
+	}
+	return nil, nil
+}
+
+// ourPSBTMultisigInput matches a P2WSH or P2WSH-P2SH input against our root fingerprint and the
+// given multisig accounts. It returns the account, the keypath and the key signing the input.
+func ourPSBTMultisigInput(
+	p *psbt, index int, fingerprint []byte, utxo *rawTxOut, accounts []*psbtMultisigAccount,
+) (*psbtMultisigAccount, []uint32, *psbtInputSigner, error) {
+	input := p.inputs[index]
+	pubkey, origin, err := input.ourKey(psbtInBIP32Derivation, fingerprint)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if origin == nil {
+		return nil, nil, nil, errors.New("input does not belong to this device")
+	}
+	if err := checkPSBTSighashType(input, false); err != nil {
+		return nil, nil, nil, err
+	}
+	multisig, err := matchPSBTMultisig(accounts, origin.keypath, utxo.PkScript,
+		input.get(psbtInRedeemScript), input.get(psbtInWitnessScript))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if multisig == nil {
+		return nil, nil, nil, errors.New("input does not belong to any of the multisig accounts")
+	}
+	ourPubkey, err := multisig.account.xpubs[multisig.account.ourXPubIndex].pubkey(origin.keypath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !bytes.Equal(pubkey, ourPubkey.SerializeCompressed()) {
+		return nil, nil, nil, errors.New("public key does not match the keypath")
+	}
+	return multisig, origin.keypath, &psbtInputSigner{pubkey: pubkey}, nil
+}
+
+// ourPSBTMultisigOutput returns the multisig account and keypath of a P2WSH or P2WSH-P2SH change
+// output, or nil if the output does not belong to any of the given multisig accounts.
+func ourPSBTMultisigOutput(
+	p *psbt, index int, fingerprint []byte, accounts []*psbtMultisigAccount,
+) (*psbtMultisigAccount, []uint32, error) {
+	output := p.outputs[index]
+	pkScript := p.tx.Outputs[index].PkScript
+	if !isPSBTMultisig(pkScript, output.get(psbtOutRedeemScript)) {
+		return nil, nil, nil
+	}
+	_, origin, err := output.ourKey(psbtOutBIP32Derivation, fingerprint)
+	if err != nil || origin == nil {
+		return nil, nil, err
+	}
+	multisig, err := matchPSBTMultisig(accounts, origin.keypath, pkScript,
+		output.get(psbtOutRedeemScript), output.get(psbtOutWitnessScript))
+	if err != nil || multisig == nil {
+		// An output not matching its keypath is not treated as change, so that the device shows
+		// it to the user like any other output.
+		return nil, nil, nil
+	}
+	return multisig, origin.keypath, nil
+}
+
+// ourPSBTOutput matches an output against our root fingerprint. It returns the script type and
+// keypath of a change output, or a nil keypath if the output is not ours.
+func ourPSBTOutput(
+	p *psbt, index int, fingerprint []byte,
+) (messages.BTCScriptConfig_SimpleType, []uint32, error) {
+	output := p.outputs[index]
+	pkScript := p.tx.Outputs[index].PkScript
+	var simpleType messages.BTCScriptConfig_SimpleType
+	var origin *psbtKeyOrigin
+	var err error
+	switch {
+	case isP2WPKH(pkScript):
+		simpleType = messages.BTCScriptConfig_P2WPKH
+		_, origin, err = output.ourKey(psbtOutBIP32Derivation, fingerprint)
+	case isP2SH(pkScript) && isP2WPKH(output.get(psbtOutRedeemScript)):
+		simpleType = messages.BTCScriptConfig_P2WPKH_P2SH
+		_, origin, err = output.ourKey(psbtOutBIP32Derivation, fingerprint)
+	case isP2TR(pkScript):
+		simpleType = messages.BTCScriptConfig_P2TR
+		_, origin, err = output.ourKey(psbtOutTapBIP32Derivation, fingerprint)
+		if err == nil && origin != nil && len(origin.leafHashes) != 0 {
+			// Only keypath outputs can be verified as change.
+			origin = nil
+		}
+	}
+	if err != nil || origin == nil {
+		return 0, nil, err
+	}
+	return simpleType, origin.keypath, nil
+}
+
+// checkPSBTScript checks that the key derived from the account xpub at the keypath of an input or
+// change output matches its pubkey script and, for P2WPKH-P2SH, its redeem script. If psbtPubkey is
+// not nil, it must match the derived key as well. This makes sure a malformed PSBT can't make the
+// device sign for a different script than the one being spent.
+func checkPSBTScript(
+	account *accountXPub,
+	simpleType messages.BTCScriptConfig_SimpleType,
+	keypath []uint32,
+	psbtPubkey []byte,
+	pkScript []byte,
+	redeemScript []byte,
+) error {
+	pubkey, err := account.pubkey(keypath)
+	if err != nil {
+		return err
+	}
+	if psbtPubkey != nil {
+		expectedPubkey := pubkey.SerializeCompressed()
+		if simpleType == messages.BTCScriptConfig_P2TR {
+			expectedPubkey = schnorr.SerializePubKey(pubkey)
+		}
+		if !bytes.Equal(psbtPubkey, expectedPubkey) {
+			return errors.New("public key does not match the keypath")
+		}
+	}
+	expectedPkScript, err := simplePkScript(simpleType, pubkey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pkScript, expectedPkScript) {
+		return errors.New("script does not match the keypath")
+	}
+	if simpleType == messages.BTCScriptConfig_P2WPKH_P2SH {
+		expectedRedeemScript, err := outputPkScript(
+			messages.BTCOutputType_P2WPKH, hash160(pubkey.SerializeCompressed()))
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(redeemScript, expectedRedeemScript) {
+			return errors.New("redeem script does not match the keypath")
+		}
+	}
+	return nil
+}
+
+// accountKeypath returns the account-level keypath of an address-level keypath ending in
+// <change>/<address>.
+func accountKeypath(keypath []uint32) ([]uint32, error) {
+	if len(keypath) < 2 {
+		return nil, errors.New("keypath too short")
+	}
+	return keypath[:len(keypath)-2], nil
+}
+
+// signPSBT signs all inputs of the PSBT with the device and adds the signatures to it. P2WSH and
+// P2WSH-P2SH inputs and change outputs are matched against the given multisig accounts.
+func (device *jsDevice) signPSBT(
+	coin messages.BTCCoin, p *psbt, multisigAccounts []*psbtMultisigAccount) error {
+	fingerprint, err := device.device.RootFingerprint()
+	if err != nil {
+		return err
+	}
+	var scriptConfigs scriptConfigList
+	signers := make([]*psbtInputSigner, len(p.tx.Inputs))
+	inputs := make([]*firmware.BTCTxInput, len(p.tx.Inputs))
+	for i, txIn := range p.tx.Inputs {
+		utxo, prevTx, err := p.inputUTXO(i)
+		if err != nil {
+			return fmt.Errorf("input %d: %v", i, err)
+		}
+		var scriptConfig *messages.BTCScriptConfig
+		var account, keypath []uint32
+		var signer *psbtInputSigner
+		if isPSBTMultisig(utxo.PkScript, p.inputs[i].get(psbtInRedeemScript)) {
+			var multisig *psbtMultisigAccount
+			multisig, keypath, signer, err = ourPSBTMultisigInput(
+				p, i, fingerprint, utxo, multisigAccounts)
+			if err != nil {
+				return fmt.Errorf("input %d: %v", i, err)
+			}
+			scriptConfig, account = multisig.scriptConfig, multisig.keypathAccount
+		} else {
+			var simpleType messages.BTCScriptConfig_SimpleType
+			simpleType, keypath, signer, err = ourPSBTInput(p, i, fingerprint, utxo)
+			if err != nil {
+				return fmt.Errorf("input %d: %v", i, err)
+			}
+			account, err = accountKeypath(keypath)
+			if err != nil {
+				return fmt.Errorf("input %d: %v", i, err)
+			}
+			xpub, err := device.accountXPub(coin, account)
+			if err != nil {
+				return err
+			}
+			if err := checkPSBTScript(xpub, simpleType, keypath, signer.pubkey,
+				utxo.PkScript, p.inputs[i].get(psbtInRedeemScript)); err != nil {
+				return fmt.Errorf("input %d: %v", i, err)
+			}
+			scriptConfig = firmware.NewBTCScriptConfigSimple(simpleType)
+		}
+		signers[i] = signer
+		inputs[i] = &firmware.BTCTxInput{
+			Input: &messages.BTCSignInputRequest{
+				PrevOutHash:       txIn.PrevOutHash,
+				PrevOutIndex:      txIn.PrevOutIndex,
+				PrevOutValue:      utxo.Value,
+				Sequence:          txIn.Sequence,
+				Keypath:           keypath,
+				ScriptConfigIndex: scriptConfigs.index(scriptConfig, account),
+			},
+		}
+		if prevTx != nil {
+			inputs[i].PrevTx = prevTx.toPrevTx()
+		}
+	}
+	if firmware.BTCSignNeedsPrevTxs(scriptConfigs) {
+		for i, input := range inputs {
+			if input.PrevTx == nil {
+				return fmt.Errorf(
+					"input %d: missing previous transaction (PSBT_IN_NON_WITNESS_UTXO)", i)
+			}
+		}
+	}
+	outputs := make([]*messages.BTCSignOutputRequest, len(p.tx.Outputs))
+	for i, txOut := range p.tx.Outputs {
+		multisig, keypath, err := ourPSBTMultisigOutput(p, i, fingerprint, multisigAccounts)
+		if err != nil {
+			return fmt.Errorf("output %d: %v", i, err)
+		}
+		if multisig != nil {
+			outputs[i] = &messages.BTCSignOutputRequest{
+				Ours:    true,
+				Value:   txOut.Value,
+				Keypath: keypath,
+				ScriptConfigIndex: scriptConfigs.index(
+					multisig.scriptConfig, multisig.keypathAccount),
+			}
+			continue
+		}
+		simpleType, keypath, err := ourPSBTOutput(p, i, fingerprint)
+		if err != nil {
+			return fmt.Errorf("output %d: %v", i, err)
+		}
+		if keypath != nil {
+			account, err := accountKeypath(keypath)
+			if err != nil {
+				return fmt.Errorf("output %d: %v", i, err)
+			}
+			xpub, err := device.accountXPub(coin, account)
+			if err != nil {
+				return err
+			}
+			// An output not matching its keypath is not treated as change, so that the device shows
+			// it to the user like any other output.
+			if checkPSBTScript(xpub, simpleType, keypath, nil,
+				txOut.PkScript, p.outputs[i].get(psbtOutRedeemScript)) == nil {
+				outputs[i] = &messages.BTCSignOutputRequest{
+					Ours:    true,
+					Value:   txOut.Value,
+					Keypath: keypath,
+					ScriptConfigIndex: scriptConfigs.index(
+						firmware.NewBTCScriptConfigSimple(simpleType), account),
+				}
+				continue
+			}
+		}
+		outputType, payload, err := outputTypeAndPayload(txOut.PkScript)
+		if err != nil {
+			return fmt.Errorf("output %d: %v", i, err)
+		}
+		outputs[i] = &messages.BTCSignOutputRequest{
+			Type:    outputType,
+			Value:   txOut.Value,
+			Payload: payload,
+		}
+	}
+	tx := &firmware.BTCTx{
+		Version:  p.tx.Version,
+		Inputs:   inputs,
+		Outputs:  outputs,
+		Locktime: p.tx.Locktime,
+	}
+	if err := device.checkSign(coin, scriptConfigs, tx); err != nil {
+		return err
+	}
+	signatures, err := device.device.BTCSign(
+		coin, scriptConfigs, tx, messages.BTCSignInitRequest_DEFAULT)
+	if err != nil {
+		return err
+	}
+	if err := device.verifySignatures(coin, scriptConfigs, tx, signatures); err != nil {
+		return err
+	}
+	addPSBTSignatures(p, signers, signatures)
+	return nil
+}
+
+// addPSBTSignatures adds the 64 byte signatures returned by the device to the PSBT inputs, as
+// TAP_KEY_SIG for taproot inputs and as PARTIAL_SIG with SIGHASH_ALL otherwise.
+func addPSBTSignatures(p *psbt, signers []*psbtInputSigner, signatures [][]byte) {
+	for i, signer := range signers {
+		if signer.taproot {
+			p.inputs[i].set([]byte{psbtInTapKeySig}, signatures[i])
+		} else {
+			p.inputs[i].set(
+				append([]byte{psbtInPartialSig}, signer.pubkey...),
+				append(derSignature(signatures[i]), 0x01),
+			)
+		}
+	}
+}
+
+type btcSignPSBTOptions struct {
+	*js.Object
+	Coin     messages.BTCCoin     `js:"coin"`
+	Multisig []*btcMultisigConfig `js:"multisig"`
+}
+
+// multisigAccounts converts the multisig accounts of the options, which must be of the coin being
+// signed for and supported by the device.
+func (device *jsDevice) multisigAccounts(
+	options *btcSignPSBTOptions) ([]*psbtMultisigAccount, error) {
+	accounts := make([]*psbtMultisigAccount, len(options.Multisig))
+	for i, config := range options.Multisig {
+		if config.Coin != options.Coin {
+			return nil, fmt.Errorf("multisig account %d: coin does not match", i)
+		}
+		scriptConfig, err := config.toScriptConfig()
+		if err != nil {
+			return nil, fmt.Errorf("multisig account %d: %v", i, err)
+		}
+		if err := device.checkScriptConfigSupported(scriptConfig); err != nil {
+			return nil, err
+		}
+		accounts[i] = &psbtMultisigAccount{
+			keypathAccount: config.KeypathAccount,
+			scriptConfig:   scriptConfig,
+			account:        newMultisigAccount(scriptConfig.GetMultisig(), config.KeypathAccount),
+		}
+	}
+	return accounts, nil
+}
+
+// AsyncBTCSignPSBT signs a base64 encoded PSBT. All inputs must belong to this device, matched by
+// the root fingerprint in their BIP32 derivations, and the key at their keypath must match the
+// script being spent. Inputs of other wallets can't be skipped, as the device signs all inputs of
+// a transaction. P2WSH and P2WSH-P2SH inputs must belong to one of the multisig accounts in
+// options.multisig, which must be registered on the device. Change outputs are detected the same
+// way. Inputs of policy accounts are not supported, as their scripts can't be matched without
+// compiling the policy.
+// Returns the base64 encoded PSBT with the signatures added as PARTIAL_SIG or TAP_KEY_SIG fields.
+func (device *jsDevice) AsyncBTCSignPSBT(
+	done func(string, *jsError),
+	psbtBase64 string,
+	options *btcSignPSBTOptions,
+) {
+	go func() {
+		p, err := parsePSBT(psbtBase64)
+		if err != nil {
+			done("", toJSError(err))
+			return
+		}
+		multisigAccounts, err := device.multisigAccounts(options)
+		if err != nil {
+			done("", toJSError(err))
+			return
+		}
+		if err := device.signPSBT(options.Coin, p, multisigAccounts); err != nil {
+			done("", toJSError(err))
+			return
+		}
+		done(p.base64(), nil)
+	}()
+}
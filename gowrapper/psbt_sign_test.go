@@ -0,0 +1,396 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+func TestMatchPSBTMultisig(t *testing.T) {
+	xpub := func(privKey string) *messages.XPub {
+		_, pubkey := btcec.PrivKeyFromBytes(unhex(t, privKey))
+		return &messages.XPub{PublicKey: pubkey.SerializeCompressed(), ChainCode: make([]byte, 32)}
+	}
+	keypathAccount := []uint32{
+		48 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart, 1 + hardenedKeyStart}
+	multisig := func(scriptType messages.BTCScriptConfig_Multisig_ScriptType) *psbtMultisigAccount {
+		config := &messages.BTCScriptConfig_Multisig{
+			Threshold: 1,
+			Xpubs: []*messages.XPub{
+				xpub("0101010101010101010101010101010101010101010101010101010101010101"),
+				xpub("0202020202020202020202020202020202020202020202020202020202020202"),
+			},
+			ScriptType: scriptType,
+		}
+		return &psbtMultisigAccount{
+			keypathAccount: keypathAccount,
+			scriptConfig: &messages.BTCScriptConfig{
+				Config: &messages.BTCScriptConfig_Multisig_{Multisig: config},
+			},
+			account: newMultisigAccount(config, keypathAccount),
+		}
+	}
+	p2wsh := multisig(messages.BTCScriptConfig_Multisig_P2WSH)
+	p2wshP2SH := multisig(messages.BTCScriptConfig_Multisig_P2WSH_P2SH)
+	accounts := []*psbtMultisigAccount{p2wsh, p2wshP2SH}
+	keypath := append(append([]uint32{}, keypathAccount...), 1, 5)
+	witnessScript, _, err := p2wsh.account.witnessScript(keypath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	redeemScript, err := p2wsh.account.pkScript(keypath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrappedPkScript, err := p2wshP2SH.account.pkScript(keypath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name          string
+		pkScript      []byte
+		redeemScript  []byte
+		witnessScript []byte
+		expected      *psbtMultisigAccount
+	}{
+		{"p2wsh", redeemScript, nil, witnessScript, p2wsh},
+		{"p2wsh without witness script", redeemScript, nil, nil, p2wsh},
+		{"p2wsh-p2sh", wrappedPkScript, redeemScript, witnessScript, p2wshP2SH},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			account, err := matchPSBTMultisig(
+				accounts, keypath, test.pkScript, test.redeemScript, test.witnessScript)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if account != test.expected {
+				t.Errorf("matched the wrong account")
+			}
+		})
+	}
+
+	// Other address of the account.
+	otherKeypath := append(append([]uint32{}, keypathAccount...), 1, 6)
+	account, err := matchPSBTMultisig(accounts, otherKeypath, redeemScript, nil, nil)
+	if err != nil || account != nil {
+		t.Errorf("expected no match, got %v, %v", account, err)
+	}
+	// Keypath not below the account.
+	otherAccount := append(append([]uint32{}, keypathAccount[:3]...), 2+hardenedKeyStart, 1, 5)
+	account, err = matchPSBTMultisig(accounts, otherAccount, redeemScript, nil, nil)
+	if err != nil || account != nil {
+		t.Errorf("expected no match, got %v, %v", account, err)
+	}
+	_, err = matchPSBTMultisig(accounts, keypath, redeemScript, nil, witnessScript[1:])
+	if err == nil {
+		t.Error("expected an error for a wrong witness script")
+	}
+	if _, err := matchPSBTMultisig(accounts, keypath, wrappedPkScript, nil, nil); err == nil {
+		t.Error("expected an error for a missing redeem script")
+	}
+}
+
+// testPSBTKeys are the account xpub of BIP32 test vector 1 at m/0H, and the keypath, public key and
+// pubkey scripts of one of its receive addresses.
+type testPSBTKeys struct {
+	account    *accountXPub
+	keypath    []uint32
+	pubkey     []byte
+	xOnly      []byte
+	p2wpkh     []byte
+	p2wpkhP2SH []byte
+	redeem     []byte
+	p2tr       []byte
+	p2pkh      []byte
+}
+
+// newTestPSBTKeys returns the keys of the address m/0H/0/<index>.
+func newTestPSBTKeys(t *testing.T, index uint32) *testPSBTKeys {
+	t.Helper()
+	xpub, err := firmware.NewXPub("xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := &testPSBTKeys{
+		account: &accountXPub{keypath: []uint32{0 + hardenedKeyStart}, xpub: xpub},
+		keypath: []uint32{0 + hardenedKeyStart, 0, index},
+	}
+	pubkey, err := keys.account.pubkey(keys.keypath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys.pubkey = pubkey.SerializeCompressed()
+	keys.xOnly = schnorr.SerializePubKey(pubkey)
+	for _, script := range []struct {
+		simpleType messages.BTCScriptConfig_SimpleType
+		pkScript   *[]byte
+	}{
+		{messages.BTCScriptConfig_P2WPKH, &keys.p2wpkh},
+		{messages.BTCScriptConfig_P2WPKH_P2SH, &keys.p2wpkhP2SH},
+		{messages.BTCScriptConfig_P2TR, &keys.p2tr},
+	} {
+		if *script.pkScript, err = simplePkScript(script.simpleType, pubkey); err != nil {
+			t.Fatal(err)
+		}
+	}
+	keys.redeem = keys.p2wpkh
+	keys.p2pkh, err = outputPkScript(messages.BTCOutputType_P2PKH, hash160(keys.pubkey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+func TestOurPSBTInput(t *testing.T) {
+	fingerprint := unhex(t, "f23f9fd2")
+	keys := newTestPSBTKeys(t, 5)
+	bip32 := &psbtKV{
+		key:   append([]byte{psbtInBIP32Derivation}, keys.pubkey...),
+		value: testKeyOrigin(fingerprint, keys.keypath...),
+	}
+	tapBIP32 := &psbtKV{
+		key:   append([]byte{psbtInTapBIP32Derivation}, keys.xOnly...),
+		value: testTapKeyOrigin(nil, fingerprint, keys.keypath...),
+	}
+	redeem := &psbtKV{key: []byte{psbtInRedeemScript}, value: keys.redeem}
+	sighash := func(sighashType byte) *psbtKV {
+		return &psbtKV{key: []byte{psbtInSighashType}, value: []byte{sighashType, 0, 0, 0}}
+	}
+
+	for _, test := range []struct {
+		name       string
+		pkScript   []byte
+		input      psbtMap
+		simpleType messages.BTCScriptConfig_SimpleType
+		pubkey     []byte
+	}{
+		{"p2wpkh", keys.p2wpkh, psbtMap{bip32}, messages.BTCScriptConfig_P2WPKH, keys.pubkey},
+		{"p2wpkh sighash all", keys.p2wpkh, psbtMap{bip32, sighash(1)},
+			messages.BTCScriptConfig_P2WPKH, keys.pubkey},
+		{"p2wpkh-p2sh", keys.p2wpkhP2SH, psbtMap{redeem, bip32},
+			messages.BTCScriptConfig_P2WPKH_P2SH, keys.pubkey},
+		{"p2tr", keys.p2tr, psbtMap{tapBIP32}, messages.BTCScriptConfig_P2TR, keys.xOnly},
+		{"p2tr sighash default", keys.p2tr, psbtMap{tapBIP32, sighash(0)},
+			messages.BTCScriptConfig_P2TR, keys.xOnly},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			p := &psbt{inputs: []psbtMap{test.input}}
+			simpleType, keypath, signer, err := ourPSBTInput(
+				p, 0, fingerprint, &rawTxOut{Value: 1000, PkScript: test.pkScript})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if simpleType != test.simpleType {
+				t.Errorf("got script type %s, expected %s", simpleType, test.simpleType)
+			}
+			if !reflect.DeepEqual(keypath, keys.keypath) {
+				t.Errorf("got keypath %v, expected %v", keypath, keys.keypath)
+			}
+			taproot := test.simpleType == messages.BTCScriptConfig_P2TR
+			if signer.taproot != taproot || !bytes.Equal(signer.pubkey, test.pubkey) {
+				t.Errorf("got signer %+v", signer)
+			}
+			// The key matches the script being spent.
+			if err := checkPSBTScript(keys.account, simpleType, keypath, signer.pubkey,
+				test.pkScript, test.input.get(psbtInRedeemScript)); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	for _, test := range []struct {
+		name     string
+		pkScript []byte
+		input    psbtMap
+	}{
+		{"p2pkh", keys.p2pkh, psbtMap{bip32}},
+		{"p2sh without redeem script", keys.p2wpkhP2SH, psbtMap{bip32}},
+		{"no derivation", keys.p2wpkh, psbtMap{}},
+		{"other device", keys.p2wpkh, psbtMap{{
+			key:   bip32.key,
+			value: testKeyOrigin(unhex(t, "01020304"), keys.keypath...),
+		}}},
+		{"taproot script path", keys.p2tr, psbtMap{{
+			key: tapBIP32.key,
+			value: testTapKeyOrigin(
+				[][]byte{bytes.Repeat([]byte{0xaa}, 32)}, fingerprint, keys.keypath...),
+		}}},
+		{"x-only pubkey for p2wpkh", keys.p2wpkh, psbtMap{{
+			key:   append([]byte{psbtInBIP32Derivation}, keys.xOnly...),
+			value: bip32.value,
+		}}},
+		{"sighash single", keys.p2wpkh, psbtMap{bip32, sighash(3)}},
+		{"taproot sighash all", keys.p2tr, psbtMap{tapBIP32, sighash(1)}},
+		{"invalid sighash", keys.p2wpkh, psbtMap{bip32, {
+			key: []byte{psbtInSighashType}, value: []byte{1},
+		}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			p := &psbt{inputs: []psbtMap{test.input}}
+			if _, _, _, err := ourPSBTInput(
+				p, 0, fingerprint, &rawTxOut{Value: 1000, PkScript: test.pkScript}); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestCheckPSBTScript(t *testing.T) {
+	keys := newTestPSBTKeys(t, 5)
+	other := newTestPSBTKeys(t, 6)
+	for _, test := range []struct {
+		name         string
+		simpleType   messages.BTCScriptConfig_SimpleType
+		keypath      []uint32
+		pubkey       []byte
+		pkScript     []byte
+		redeemScript []byte
+	}{
+		{"pubkey of another address", messages.BTCScriptConfig_P2WPKH,
+			keys.keypath, other.pubkey, keys.p2wpkh, nil},
+		{"script of another address", messages.BTCScriptConfig_P2WPKH,
+			keys.keypath, nil, other.p2wpkh, nil},
+		{"script of another type", messages.BTCScriptConfig_P2WPKH,
+			keys.keypath, keys.pubkey, keys.p2tr, nil},
+		{"redeem script of another address", messages.BTCScriptConfig_P2WPKH_P2SH,
+			keys.keypath, keys.pubkey, keys.p2wpkhP2SH, other.redeem},
+		{"missing redeem script", messages.BTCScriptConfig_P2WPKH_P2SH,
+			keys.keypath, keys.pubkey, keys.p2wpkhP2SH, nil},
+		{"compressed pubkey for p2tr", messages.BTCScriptConfig_P2TR,
+			keys.keypath, keys.pubkey, keys.p2tr, nil},
+		{"keypath of another account", messages.BTCScriptConfig_P2WPKH,
+			[]uint32{1 + hardenedKeyStart, 0, 5}, nil, keys.p2wpkh, nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if err := checkPSBTScript(keys.account, test.simpleType, test.keypath, test.pubkey,
+				test.pkScript, test.redeemScript); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestOurPSBTOutput(t *testing.T) {
+	fingerprint := unhex(t, "f23f9fd2")
+	keys := newTestPSBTKeys(t, 5)
+	bip32 := &psbtKV{
+		key:   append([]byte{psbtOutBIP32Derivation}, keys.pubkey...),
+		value: testKeyOrigin(fingerprint, keys.keypath...),
+	}
+	tapBIP32 := &psbtKV{
+		key:   append([]byte{psbtOutTapBIP32Derivation}, keys.xOnly...),
+		value: testTapKeyOrigin(nil, fingerprint, keys.keypath...),
+	}
+	redeem := &psbtKV{key: []byte{psbtOutRedeemScript}, value: keys.redeem}
+	for _, test := range []struct {
+		name       string
+		pkScript   []byte
+		output     psbtMap
+		change     bool
+		simpleType messages.BTCScriptConfig_SimpleType
+	}{
+		{"p2wpkh", keys.p2wpkh, psbtMap{bip32}, true, messages.BTCScriptConfig_P2WPKH},
+		{"p2wpkh-p2sh", keys.p2wpkhP2SH, psbtMap{redeem, bip32}, true,
+			messages.BTCScriptConfig_P2WPKH_P2SH},
+		{"p2tr", keys.p2tr, psbtMap{tapBIP32}, true, messages.BTCScriptConfig_P2TR},
+		{"no derivation", keys.p2wpkh, psbtMap{}, false, 0},
+		{"p2pkh", keys.p2pkh, psbtMap{bip32}, false, 0},
+		{"p2sh without redeem script", keys.p2wpkhP2SH, psbtMap{bip32}, false, 0},
+		{"other device", keys.p2wpkh, psbtMap{{
+			key:   bip32.key,
+			value: testKeyOrigin(unhex(t, "01020304"), keys.keypath...),
+		}}, false, 0},
+		{"taproot script path", keys.p2tr, psbtMap{{
+			key: tapBIP32.key,
+			value: testTapKeyOrigin(
+				[][]byte{bytes.Repeat([]byte{0xaa}, 32)}, fingerprint, keys.keypath...),
+		}}, false, 0},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			p := &psbt{
+				tx:      &rawTx{Outputs: []*rawTxOut{{Value: 1000, PkScript: test.pkScript}}},
+				outputs: []psbtMap{test.output},
+			}
+			simpleType, keypath, err := ourPSBTOutput(p, 0, fingerprint)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !test.change {
+				if keypath != nil {
+					t.Errorf("expected no change output, got keypath %v", keypath)
+				}
+				return
+			}
+			if simpleType != test.simpleType || !reflect.DeepEqual(keypath, keys.keypath) {
+				t.Errorf("got %s, %v", simpleType, keypath)
+			}
+			if err := checkPSBTScript(keys.account, simpleType, keypath, nil,
+				test.pkScript, test.output.get(psbtOutRedeemScript)); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	// A change output whose script does not match its keypath fails the script check, so that it
+	// is shown as a regular output.
+	other := newTestPSBTKeys(t, 6)
+	p := &psbt{
+		tx:      &rawTx{Outputs: []*rawTxOut{{Value: 1000, PkScript: other.p2wpkh}}},
+		outputs: []psbtMap{{bip32}},
+	}
+	simpleType, keypath, err := ourPSBTOutput(p, 0, fingerprint)
+	if err != nil || keypath == nil {
+		t.Fatalf("expected a change candidate, got %v, %v", keypath, err)
+	}
+	if checkPSBTScript(keys.account, simpleType, keypath, nil, other.p2wpkh, nil) == nil {
+		t.Error("expected the script check to fail")
+	}
+}
+
+func TestAddPSBTSignatures(t *testing.T) {
+	keys := newTestPSBTKeys(t, 5)
+	p := &psbt{inputs: []psbtMap{{}, {}}}
+	signatures := [][]byte{
+		append(bytes.Repeat([]byte{0x01}, 32), bytes.Repeat([]byte{0x02}, 32)...),
+		bytes.Repeat([]byte{0x03}, 64),
+	}
+	addPSBTSignatures(p, []*psbtInputSigner{
+		{pubkey: keys.pubkey},
+		{taproot: true, pubkey: keys.xOnly},
+	}, signatures)
+
+	partialSig := p.inputs[0].get(append([]byte{psbtInPartialSig}, keys.pubkey...)...)
+	expected := append(derSignature(signatures[0]), 0x01)
+	if !bytes.Equal(partialSig, expected) {
+		t.Errorf("got PARTIAL_SIG %x, expected %x", partialSig, expected)
+	}
+	if p.inputs[0].get(psbtInTapKeySig) != nil {
+		t.Error("unexpected TAP_KEY_SIG for an ECDSA input")
+	}
+	if tapKeySig := p.inputs[1].get(psbtInTapKeySig); !bytes.Equal(tapKeySig, signatures[1]) {
+		t.Errorf("got TAP_KEY_SIG %x, expected %x", tapKeySig, signatures[1])
+	}
+	if len(p.inputs[1].getAll(psbtInPartialSig)) != 0 {
+		t.Error("unexpected PARTIAL_SIG for a taproot input")
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+)
+
+// bip143PSBT returns a BIP174 PSBT of the native P2WPKH example of BIP143. The first input, which
+// spends a P2PK output, is already finalized, and the second input has its partial signature.
+func bip143PSBT(t *testing.T, pubkey []byte) *psbt {
+	t.Helper()
+	signed, err := parseRawTx(unhex(t, bip143SignedTx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	witnessUTXO := bytes.Buffer{}
+	(&rawTxOut{
+		Value:    600000000,
+		PkScript: unhex(t, "00141d0f172a0ecb48aee1be1f2687d2963ae33f71a1"),
+	}).serialize(&witnessUTXO)
+	p := &psbt{
+		global: psbtMap{{key: []byte{psbtGlobalUnsignedTx}, value: unhex(t, bip143UnsignedTx)}},
+		inputs: []psbtMap{
+			{{key: []byte{psbtInFinalScriptSig}, value: signed.Inputs[0].ScriptSig}},
+			{
+				{key: []byte{psbtInWitnessUTXO}, value: witnessUTXO.Bytes()},
+				{key: append([]byte{psbtInPartialSig}, pubkey...), value: signed.Inputs[1].Witness[0]},
+			},
+		},
+		outputs: []psbtMap{nil, nil},
+	}
+	// Go through the wire format to populate the unsigned transaction.
+	p, err = readPSBT(p.serialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestPSBTRoundTrip(t *testing.T) {
+	p := bip143PSBT(t, unhex(t, "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357"))
+	if !bytes.HasPrefix(p.serialize(), []byte("psbt\xff")) {
+		t.Error("missing magic")
+	}
+	parsed, err := parsePSBT(p.base64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(parsed.serialize(), p.serialize()) {
+		t.Error("round trip failed")
+	}
+	utxo, prevTx, err := parsed.inputUTXO(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if utxo.Value != 600000000 || prevTx != nil {
+		t.Errorf("unexpected UTXO: %+v, %v", utxo, prevTx)
+	}
+	if _, _, err := parsed.inputUTXO(0); err == nil {
+		t.Error("expected an error for the input without UTXO")
+	}
+}
+
+func TestPSBTFinalizeExtract(t *testing.T) {
+	p := bip143PSBT(t, unhex(t, "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357"))
+	if _, err := p.extract(); err == nil {
+		t.Error("expected an error when extracting a PSBT that is not finalized")
+	}
+	if err := p.finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if !p.inputFinalized(1) {
+		t.Fatal("input not finalized")
+	}
+	// The partial signature is removed, the UTXO is kept.
+	if p.inputs[1].get(psbtInWitnessUTXO) == nil || len(p.inputs[1].getAll(psbtInPartialSig)) != 0 {
+		t.Error("unexpected fields after finalizing")
+	}
+	tx, err := p.extract()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tx.serialize(true), unhex(t, bip143SignedTx)) {
+		t.Errorf("got tx %x", tx.serialize(true))
+	}
+}
+
+func TestPSBTFinalizeWrongPubkey(t *testing.T) {
+	p := bip143PSBT(t, unhex(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"))
+	if err := p.finalize(); err == nil {
+		t.Error("expected an error for a signature of a different key")
+	}
+}
+
+func TestParsePSBTErrors(t *testing.T) {
+	p := bip143PSBT(t, unhex(t, "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357"))
+	raw := p.serialize()
+	encode := base64.StdEncoding.EncodeToString
+	signedTx := &psbt{
+		global:  psbtMap{{key: []byte{psbtGlobalUnsignedTx}, value: unhex(t, bip143SignedTx)}},
+		inputs:  []psbtMap{nil, nil},
+		outputs: []psbtMap{nil, nil},
+	}
+	version2 := &psbt{
+		global: psbtMap{
+			{key: []byte{psbtGlobalUnsignedTx}, value: unhex(t, bip143UnsignedTx)},
+			{key: []byte{psbtGlobalVersion}, value: []byte{2, 0, 0, 0}},
+		},
+		inputs:  []psbtMap{nil, nil},
+		outputs: []psbtMap{nil, nil},
+	}
+	duplicateKey := bip143PSBT(t, unhex(t, "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357"))
+	duplicateKey.inputs[1] = append(duplicateKey.inputs[1], duplicateKey.inputs[1][0])
+	tests := []struct {
+		name string
+		psbt string
+	}{
+		{"not base64", "cHNidP8B!"},
+		{"network transaction", encode(unhex(t, bip143UnsignedTx))},
+		{"missing unsigned tx", encode(append([]byte("psbt\xff"), 0x00))},
+		{"signed tx", encode(signedTx.serialize())},
+		{"version 2", encode(version2.serialize())},
+		{"truncated", encode(raw[:len(raw)-1])},
+		{"trailing data", encode(append(append([]byte{}, raw...), 0x00))},
+		{"duplicate key", encode(duplicateKey.serialize())},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := parsePSBT(test.psbt); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+// testKeyOrigin returns the value of a BIP32 derivation field.
+func testKeyOrigin(fingerprint []byte, keypath ...uint32) []byte {
+	var buf bytes.Buffer
+	buf.Write(fingerprint)
+	for _, element := range keypath {
+		_ = binary.Write(&buf, binary.LittleEndian, element)
+	}
+	return buf.Bytes()
+}
+
+// testTapKeyOrigin returns the value of a taproot BIP32 derivation field.
+func testTapKeyOrigin(leafHashes [][]byte, fingerprint []byte, keypath ...uint32) []byte {
+	var buf bytes.Buffer
+	writeCompactSize(&buf, uint64(len(leafHashes)))
+	for _, leafHash := range leafHashes {
+		buf.Write(leafHash)
+	}
+	buf.Write(testKeyOrigin(fingerprint, keypath...))
+	return buf.Bytes()
+}
+
+func TestPSBTMapOurKeyTaproot(t *testing.T) {
+	fingerprint := unhex(t, "f23f9fd2")
+	leafKey := bytes.Repeat([]byte{0x11}, 32)
+	internalKey := bytes.Repeat([]byte{0x22}, 32)
+	otherKey := bytes.Repeat([]byte{0x33}, 32)
+	leafEntry := &psbtKV{
+		key:   append([]byte{psbtInTapBIP32Derivation}, leafKey...),
+		value: testTapKeyOrigin([][]byte{bytes.Repeat([]byte{0xaa}, 32)}, fingerprint, 0, 1),
+	}
+	// The internal key of the key path spend comes after a script leaf key of the same device.
+	m := psbtMap{
+		{
+			key:   append([]byte{psbtInTapBIP32Derivation}, otherKey...),
+			value: testTapKeyOrigin(nil, unhex(t, "01020304"), 0, 0),
+		},
+		leafEntry,
+		{
+			key:   append([]byte{psbtInTapBIP32Derivation}, internalKey...),
+			value: testTapKeyOrigin(nil, fingerprint, 0, 2),
+		},
+	}
+	pubkey, origin, err := m.ourKey(psbtInTapBIP32Derivation, fingerprint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pubkey, internalKey) || len(origin.leafHashes) != 0 ||
+		len(origin.keypath) != 2 || origin.keypath[1] != 2 {
+		t.Errorf("expected the internal key, got %x with origin %+v", pubkey, origin)
+	}
+
+	// Without a key path entry, the script leaf key is returned.
+	pubkey, origin, err = psbtMap{leafEntry}.ourKey(psbtInTapBIP32Derivation, fingerprint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pubkey, leafKey) || len(origin.leafHashes) != 1 {
+		t.Errorf("expected the leaf key, got %x with origin %+v", pubkey, origin)
+	}
+
+	pubkey, origin, err = m.ourKey(psbtInTapBIP32Derivation, unhex(t, "ffffffff"))
+	if err != nil || pubkey != nil || origin != nil {
+		t.Errorf("expected no key, got %x, %+v, %v", pubkey, origin, err)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+// AsyncCheckSDCard returns true if a microSD card is inserted.
+func (device *jsDevice) AsyncCheckSDCard(done func(bool, *jsError)) {
+	go func() {
+		inserted, err := device.device.CheckSDCard()
+		done(inserted, toJSError(err))
+	}()
+}
+
+// AsyncInsertRemoveSDCard prompts the user on the device to insert or remove the microSD card. The
+// callback is called once the card has been inserted or removed.
+func (device *jsDevice) AsyncInsertRemoveSDCard(
+	done func(*jsError),
+	action messages.InsertRemoveSDCardRequest_SDCardAction,
+) {
+	go func() {
+		done(toJSError(device.device.InsertRemoveSDCard(action)))
+	}()
+}
@@ -0,0 +1,528 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+const hardenedKeyStart = 0x80000000
+
+// accountXPub derives public keys of an account from its xpub.
+type accountXPub struct {
+	keypath []uint32
+	xpub    *messages.XPub
+}
+
+// cachedXPub returns the xpub at the given keypath as returned by the device. The xpub is fetched
+// without display once and cached. The cache lives as long as the connection, as unlocking with a
+// different passphrase requires a new connection, and is cleared when the seed changes (see
+// withSeedChange).
+func (device *jsDevice) cachedXPub(coin messages.BTCCoin, keypath []uint32) (string, error) {
+	key := fmt.Sprintf("%s/%v", coin, keypath)
+	device.mu.RLock()
+	xpub, ok := device.xpubs[key]
+	device.mu.RUnlock()
+	if ok {
+		return xpub, nil
+	}
+	xpub, err := device.device.BTCXPub(coin, keypath, messages.BTCPubRequest_XPUB, false)
+	if err != nil {
+		return "", err
+	}
+	device.mu.Lock()
+	if device.xpubs == nil {
+		device.xpubs = map[string]string{}
+	}
+	device.xpubs[key] = xpub
+	device.mu.Unlock()
+	return xpub, nil
+}
+
+// accountXPub returns the xpub of the account at the given keypath, see cachedXPub.
+func (device *jsDevice) accountXPub(coin messages.BTCCoin, keypath []uint32) (*accountXPub, error) {
+	xpubStr, err := device.cachedXPub(coin, keypath)
+	if err != nil {
+		return nil, err
+	}
+	xpub, err := firmware.NewXPub(xpubStr)
+	if err != nil {
+		return nil, err
+	}
+	return &accountXPub{keypath: keypath, xpub: xpub}, nil
+}
+
+// isOurXPub checks that xpub, given in any format, is the xpub the device derives at the keypath.
+func (device *jsDevice) isOurXPub(
+	coin messages.BTCCoin, keypath []uint32, xpub string) (bool, error) {
+	ours, err := device.accountXPub(coin, keypath)
+	if err != nil {
+		return false, err
+	}
+	parsed, err := firmware.NewXPub(xpub)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(parsed.PublicKey, ours.xpub.PublicKey) &&
+		bytes.Equal(parsed.ChainCode, ours.xpub.ChainCode), nil
+}
+
+// withSeedChange calls f, which may change the seed of the device, and clears the xpub cache
+// afterwards. The cache is cleared even if f fails, as the seed may have changed nevertheless.
+func (device *jsDevice) withSeedChange(f func() error) error {
+	err := f()
+	device.mu.Lock()
+	device.xpubs = nil
+	device.mu.Unlock()
+	return err
+}
+
+// pubkey derives the public key at the given keypath, which must be below the account and contain
+// only unhardened elements after the account keypath (BIP32 public child key derivation).
+func (account *accountXPub) pubkey(keypath []uint32) (*btcec.PublicKey, error) {
+	if len(keypath) < len(account.keypath) {
+		return nil, errors.New("keypath does not belong to the account")
+	}
+	for i, element := range account.keypath {
+		if keypath[i] != element {
+			return nil, errors.New("keypath does not belong to the account")
+		}
+	}
+	pubkey, err := btcec.ParsePubKey(account.xpub.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	chainCode := account.xpub.ChainCode
+	for _, index := range keypath[len(account.keypath):] {
+		if index >= hardenedKeyStart {
+			return nil, errors.New("cannot derive a hardened key from an xpub")
+		}
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(pubkey.SerializeCompressed())
+		_ = binary.Write(mac, binary.BigEndian, index)
+		sum := mac.Sum(nil)
+		var tweak btcec.ModNScalar
+		if overflow := tweak.SetByteSlice(sum[:32]); overflow {
+			return nil, errors.New("invalid child key")
+		}
+		var point, tweakPoint, result btcec.JacobianPoint
+		pubkey.AsJacobian(&point)
+		btcec.ScalarBaseMultNonConst(&tweak, &tweakPoint)
+		btcec.AddNonConst(&point, &tweakPoint, &result)
+		if result.Z.IsZero() {
+			return nil, errors.New("invalid child key")
+		}
+		result.ToAffine()
+		pubkey = btcec.NewPublicKey(&result.X, &result.Y)
+		chainCode = sum[32:]
+	}
+	return pubkey, nil
+}
+
+// taprootOutputKey returns the 32 byte x-only output key committing to the internal key without a
+// script path, as per BIP86.
+func taprootOutputKey(internalKey *btcec.PublicKey) ([]byte, error) {
+	xOnly := schnorr.SerializePubKey(internalKey)
+	evenKey, err := schnorr.ParsePubKey(xOnly)
+	if err != nil {
+		return nil, err
+	}
+	var tweak btcec.ModNScalar
+	if overflow := tweak.SetByteSlice(chainhash.TaggedHash([]byte("TapTweak"), xOnly)[:]); overflow {
+		return nil, errors.New("invalid taproot tweak")
+	}
+	var point, tweakPoint, result btcec.JacobianPoint
+	evenKey.AsJacobian(&point)
+	btcec.ScalarBaseMultNonConst(&tweak, &tweakPoint)
+	btcec.AddNonConst(&point, &tweakPoint, &result)
+	if result.Z.IsZero() {
+		return nil, errors.New("invalid taproot output key")
+	}
+	result.ToAffine()
+	return schnorr.SerializePubKey(btcec.NewPublicKey(&result.X, &result.Y)), nil
+}
+
+// simplePkScript returns the pubkey script of a single-sig output of the given script type.
+func simplePkScript(
+	simpleType messages.BTCScriptConfig_SimpleType, pubkey *btcec.PublicKey,
+) ([]byte, error) {
+	pubkeyHash := hash160(pubkey.SerializeCompressed())
+	switch simpleType {
+	case messages.BTCScriptConfig_P2WPKH:
+		return outputPkScript(messages.BTCOutputType_P2WPKH, pubkeyHash)
+	case messages.BTCScriptConfig_P2WPKH_P2SH:
+		redeemScript, err := outputPkScript(messages.BTCOutputType_P2WPKH, pubkeyHash)
+		if err != nil {
+			return nil, err
+		}
+		return outputPkScript(messages.BTCOutputType_P2SH, hash160(redeemScript))
+	case messages.BTCScriptConfig_P2TR:
+		outputKey, err := taprootOutputKey(pubkey)
+		if err != nil {
+			return nil, err
+		}
+		return outputPkScript(messages.BTCOutputType_P2TR, outputKey)
+	default:
+		return nil, fmt.Errorf("unsupported script type: %s", simpleType)
+	}
+}
+
+// sighashSegwitV0 computes the BIP143 SIGHASH_ALL signature hash of an input.
+func (tx *rawTx) sighashSegwitV0(index int, scriptCode []byte, value uint64) []byte {
+	var prevouts, sequences, outputs bytes.Buffer
+	for _, input := range tx.Inputs {
+		prevouts.Write(input.PrevOutHash)
+		_ = binary.Write(&prevouts, binary.LittleEndian, input.PrevOutIndex)
+		_ = binary.Write(&sequences, binary.LittleEndian, input.Sequence)
+	}
+	for _, output := range tx.Outputs {
+		output.serialize(&outputs)
+	}
+	input := tx.Inputs[index]
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.LittleEndian, tx.Version)
+	buf.Write(doubleSHA256(prevouts.Bytes()))
+	buf.Write(doubleSHA256(sequences.Bytes()))
+	buf.Write(input.PrevOutHash)
+	_ = binary.Write(&buf, binary.LittleEndian, input.PrevOutIndex)
+	writeVarBytes(&buf, scriptCode)
+	_ = binary.Write(&buf, binary.LittleEndian, value)
+	_ = binary.Write(&buf, binary.LittleEndian, input.Sequence)
+	buf.Write(doubleSHA256(outputs.Bytes()))
+	_ = binary.Write(&buf, binary.LittleEndian, tx.Locktime)
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(1)) // SIGHASH_ALL
+	return doubleSHA256(buf.Bytes())
+}
+
+// sighashTaproot computes the BIP341 SIGHASH_DEFAULT signature hash of a key path spend of an
+// input. prevOuts are the outputs spent by all inputs.
+func (tx *rawTx) sighashTaproot(index int, prevOuts []*rawTxOut) []byte {
+	var prevouts, amounts, pkScripts, sequences, outputs bytes.Buffer
+	for i, input := range tx.Inputs {
+		prevouts.Write(input.PrevOutHash)
+		_ = binary.Write(&prevouts, binary.LittleEndian, input.PrevOutIndex)
+		_ = binary.Write(&amounts, binary.LittleEndian, prevOuts[i].Value)
+		writeVarBytes(&pkScripts, prevOuts[i].PkScript)
+		_ = binary.Write(&sequences, binary.LittleEndian, input.Sequence)
+	}
+	for _, output := range tx.Outputs {
+		output.serialize(&outputs)
+	}
+	single := func(b []byte) []byte {
+		hash := sha256.Sum256(b)
+		return hash[:]
+	}
+	var buf bytes.Buffer
+	buf.WriteByte(0x00) // epoch
+	buf.WriteByte(0x00) // SIGHASH_DEFAULT
+	_ = binary.Write(&buf, binary.LittleEndian, tx.Version)
+	_ = binary.Write(&buf, binary.LittleEndian, tx.Locktime)
+	buf.Write(single(prevouts.Bytes()))
+	buf.Write(single(amounts.Bytes()))
+	buf.Write(single(pkScripts.Bytes()))
+	buf.Write(single(sequences.Bytes()))
+	buf.Write(single(outputs.Bytes()))
+	buf.WriteByte(0x00) // key path spend without annex
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(index))
+	return chainhash.TaggedHash([]byte("TapSighash"), buf.Bytes())[:]
+}
+
+// verifyECDSA verifies a 64 byte compact (r, s) ECDSA signature.
+func verifyECDSA(signature []byte, sighash []byte, pubkey *btcec.PublicKey) bool {
+	if len(signature) != 64 {
+		return false
+	}
+	var r, s btcec.ModNScalar
+	if r.SetByteSlice(signature[:32]) || s.SetByteSlice(signature[32:]) {
+		return false
+	}
+	return ecdsa.NewSignature(&r, &s).Verify(sighash, pubkey)
+}
+
+// verifySchnorr verifies a 64 byte BIP340 signature against an x-only public key.
+func verifySchnorr(signature []byte, sighash []byte, xOnlyPubkey []byte) bool {
+	sig, err := schnorr.ParseSignature(signature)
+	if err != nil {
+		return false
+	}
+	pubkey, err := schnorr.ParsePubKey(xOnlyPubkey)
+	if err != nil {
+		return false
+	}
+	return sig.Verify(sighash, pubkey)
+}
+
+// btcAccount is an account of the inputs and change outputs of a transaction, used to verify the
+// signatures returned by the device.
+type btcAccount interface {
+	// pkScript returns the pubkey script of the address at the given keypath.
+	pkScript(keypath []uint32) ([]byte, error)
+	// verify verifies the 64 byte signature of the input at the given index, spending the address
+	// at the given keypath. prevOuts are the outputs spent by all inputs.
+	verify(
+		tx *rawTx, prevOuts []*rawTxOut, index int, keypath []uint32, signature []byte,
+	) (bool, error)
+}
+
+// simpleAccount is a single-sig account.
+type simpleAccount struct {
+	xpub       *accountXPub
+	simpleType messages.BTCScriptConfig_SimpleType
+}
+
+func (account *simpleAccount) pkScript(keypath []uint32) ([]byte, error) {
+	pubkey, err := account.xpub.pubkey(keypath)
+	if err != nil {
+		return nil, err
+	}
+	return simplePkScript(account.simpleType, pubkey)
+}
+
+func (account *simpleAccount) verify(
+	tx *rawTx, prevOuts []*rawTxOut, index int, keypath []uint32, signature []byte,
+) (bool, error) {
+	if account.simpleType == messages.BTCScriptConfig_P2TR {
+		outputKey := prevOuts[index].PkScript[2:]
+		return verifySchnorr(signature, tx.sighashTaproot(index, prevOuts), outputKey), nil
+	}
+	pubkey, err := account.xpub.pubkey(keypath)
+	if err != nil {
+		return false, err
+	}
+	// The BIP143 scriptCode of P2WPKH is the corresponding P2PKH script.
+	scriptCode, err := outputPkScript(
+		messages.BTCOutputType_P2PKH, hash160(pubkey.SerializeCompressed()))
+	if err != nil {
+		return false, err
+	}
+	return verifyECDSA(
+		signature, tx.sighashSegwitV0(index, scriptCode, prevOuts[index].Value), pubkey), nil
+}
+
+// errPolicyUnverifiable is returned when verifying the signatures of a transaction with inputs or
+// change outputs of a policy account, as the scripts of a policy can't be derived without compiling
+// it.
+var errPolicyUnverifiable = errors.New("the signatures of policy accounts can't be verified")
+
+// policyAccount is a policy account. Its scripts are unknown, so a transaction spending from or
+// sending change to it can't be verified.
+type policyAccount struct{}
+
+func (account *policyAccount) pkScript(keypath []uint32) ([]byte, error) {
+	return nil, errPolicyUnverifiable
+}
+
+func (account *policyAccount) verify(
+	tx *rawTx, prevOuts []*rawTxOut, index int, keypath []uint32, signature []byte,
+) (bool, error) {
+	return false, errPolicyUnverifiable
+}
+
+// involvesPolicy returns true if an input or a change output of the transaction belongs to a
+// policy account. The signatures of such a transaction can't be verified, as the sighashes commit
+// to the policy scripts.
+func involvesPolicy(scriptConfigs []*messages.BTCScriptConfigWithKeypath, tx *firmware.BTCTx) bool {
+	isPolicy := func(scriptConfigIndex uint32) bool {
+		return int(scriptConfigIndex) < len(scriptConfigs) &&
+			scriptConfigs[scriptConfigIndex].ScriptConfig.GetPolicy() != nil
+	}
+	for _, input := range tx.Inputs {
+		if isPolicy(input.Input.ScriptConfigIndex) {
+			return true
+		}
+	}
+	for _, output := range tx.Outputs {
+		if output.Ours && isPolicy(output.ScriptConfigIndex) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyInputs verifies the 64 byte signatures returned by the device, one per input. The accounts
+// are indexed by the scriptConfigIndex of the inputs.
+func verifyInputs(
+	accounts []btcAccount,
+	inputs []*firmware.BTCTxInput,
+	unsigned *rawTx,
+	prevOuts []*rawTxOut,
+	signatures [][]byte,
+) error {
+	if len(signatures) != len(inputs) {
+		return errors.New("unexpected number of signatures")
+	}
+	for i, input := range inputs {
+		if int(input.Input.ScriptConfigIndex) >= len(accounts) {
+			return errors.New("input scriptConfigIndex out of range")
+		}
+		valid, err := accounts[input.Input.ScriptConfigIndex].verify(
+			unsigned, prevOuts, i, input.Input.Keypath, signatures[i])
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return fmt.Errorf("the signature of input %d returned by the device is invalid", i)
+		}
+	}
+	return nil
+}
+
+// verifySignatures verifies the signatures returned by the device for a transaction spending from
+// and sending change to the given accounts, indexed by scriptConfigIndex.
+func verifySignatures(accounts []btcAccount, tx *firmware.BTCTx, signatures [][]byte) error {
+	ourPkScript := func(scriptConfigIndex uint32, keypath []uint32) ([]byte, error) {
+		if int(scriptConfigIndex) >= len(accounts) {
+			return nil, errors.New("scriptConfigIndex out of range")
+		}
+		return accounts[scriptConfigIndex].pkScript(keypath)
+	}
+	unsigned, prevOuts, err := unsignedTx(tx, ourPkScript)
+	if err != nil {
+		return err
+	}
+	return verifyInputs(accounts, tx.Inputs, unsigned, prevOuts, signatures)
+}
+
+// verifySignatures verifies the signatures returned by the device against the accounts of the
+// script configs the transaction was signed with, fetching the xpubs of single-sig accounts from
+// the device. Transactions involving a policy account can't be verified and fail with
+// errPolicyUnverifiable, see involvesPolicy.
+func (device *jsDevice) verifySignatures(
+	coin messages.BTCCoin,
+	scriptConfigs []*messages.BTCScriptConfigWithKeypath,
+	tx *firmware.BTCTx,
+	signatures [][]byte,
+) error {
+	accounts := make([]btcAccount, len(scriptConfigs))
+	for i, scriptConfig := range scriptConfigs {
+		switch config := scriptConfig.ScriptConfig.Config.(type) {
+		case *messages.BTCScriptConfig_SimpleType_:
+			xpub, err := device.accountXPub(coin, scriptConfig.Keypath)
+			if err != nil {
+				return err
+			}
+			accounts[i] = &simpleAccount{xpub: xpub, simpleType: config.SimpleType}
+		case *messages.BTCScriptConfig_Multisig_:
+			accounts[i] = newMultisigAccount(config.Multisig, scriptConfig.Keypath)
+		case *messages.BTCScriptConfig_Policy_:
+			accounts[i] = &policyAccount{}
+		default:
+			return errors.New("unknown script config")
+		}
+	}
+	return verifySignatures(accounts, tx, signatures)
+}
+
+// simpleTx is a transaction spending only single-sig inputs of one account, with the data needed
+// to verify the signatures returned by the device and to assemble the signed transaction.
+type simpleTx struct {
+	simpleType messages.BTCScriptConfig_SimpleType
+	account    *simpleAccount
+	inputs     []*firmware.BTCTxInput
+	// unsigned is the transaction without scriptSigs and witnesses.
+	unsigned *rawTx
+	// prevOuts are the outputs spent by the inputs.
+	prevOuts []*rawTxOut
+	// pubkeys are the public keys of the inputs, derived from the account xpub.
+	pubkeys []*btcec.PublicKey
+}
+
+// unsignedTx converts a transaction as sent to the device to the wire format, without scriptSigs and
+// witnesses. ourPkScript returns the pubkey script of an input or change output given its
+// scriptConfigIndex and keypath. The outputs spent by the inputs are returned as well.
+func unsignedTx(
+	tx *firmware.BTCTx, ourPkScript func(scriptConfigIndex uint32, keypath []uint32) ([]byte, error),
+) (*rawTx, []*rawTxOut, error) {
+	unsigned := &rawTx{Version: tx.Version, Locktime: tx.Locktime}
+	prevOuts := make([]*rawTxOut, len(tx.Inputs))
+	for i, input := range tx.Inputs {
+		pkScript, err := ourPkScript(input.Input.ScriptConfigIndex, input.Input.Keypath)
+		if err != nil {
+			return nil, nil, err
+		}
+		prevOuts[i] = &rawTxOut{Value: input.Input.PrevOutValue, PkScript: pkScript}
+		unsigned.Inputs = append(unsigned.Inputs, &rawTxIn{
+			PrevOutHash:  input.Input.PrevOutHash,
+			PrevOutIndex: input.Input.PrevOutIndex,
+			Sequence:     input.Input.Sequence,
+		})
+	}
+	for _, output := range tx.Outputs {
+		var pkScript []byte
+		var err error
+		if output.Ours {
+			pkScript, err = ourPkScript(output.ScriptConfigIndex, output.Keypath)
+		} else {
+			pkScript, err = outputPkScript(output.Type, output.Payload)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		unsigned.Outputs = append(unsigned.Outputs, &rawTxOut{Value: output.Value, PkScript: pkScript})
+	}
+	return unsigned, prevOuts, nil
+}
+
+func newSimpleTx(
+	xpub *accountXPub,
+	simpleType messages.BTCScriptConfig_SimpleType,
+	tx *firmware.BTCTx,
+) (*simpleTx, error) {
+	account := &simpleAccount{xpub: xpub, simpleType: simpleType}
+	unsigned, prevOuts, err := unsignedTx(tx, func(_ uint32, keypath []uint32) ([]byte, error) {
+		return account.pkScript(keypath)
+	})
+	if err != nil {
+		return nil, err
+	}
+	pubkeys := make([]*btcec.PublicKey, len(tx.Inputs))
+	for i, input := range tx.Inputs {
+		pubkeys[i], err = xpub.pubkey(input.Input.Keypath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &simpleTx{
+		simpleType: simpleType,
+		account:    account,
+		inputs:     tx.Inputs,
+		unsigned:   unsigned,
+		prevOuts:   prevOuts,
+		pubkeys:    pubkeys,
+	}, nil
+}
+
+// pubkeyHash returns the hash160 of the compressed public key of an input.
+func (tx *simpleTx) pubkeyHash(index int) []byte {
+	return hash160(tx.pubkeys[index].SerializeCompressed())
+}
+
+// verify verifies the 64 byte signatures returned by the device, one per input.
+func (tx *simpleTx) verify(signatures [][]byte) error {
+	return verifyInputs([]btcAccount{tx.account}, tx.inputs, tx.unsigned, tx.prevOuts, signatures)
+}
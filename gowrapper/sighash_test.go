@@ -0,0 +1,538 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+func TestSighashSegwitV0(t *testing.T) {
+	// BIP143 examples.
+	tests := []struct {
+		name       string
+		tx         string
+		index      int
+		scriptCode string
+		value      uint64
+		sighash    string
+		pubkey     string
+		signature  string
+	}{
+		{
+			name:       "native P2WPKH",
+			tx:         bip143UnsignedTx,
+			index:      1,
+			scriptCode: "76a9141d0f172a0ecb48aee1be1f2687d2963ae33f71a188ac",
+			value:      600000000,
+			sighash:    "c37af31116d1b27caf68aae9e3ac82f1477929014d5b917657d0eb49478cb670",
+			pubkey:     "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357",
+			signature:  "304402203609e17b84f6a7d30c80bfa610b5b4542f32a8a0d5447a12fb1366d7f01cc44a0220573a954c4518331561406f90300e8f3358f51928d43c212a8caed02de67eebee",
+		},
+		{
+			name:       "P2WPKH-P2SH",
+			tx:         bip143UnsignedTxP2SH,
+			index:      0,
+			scriptCode: "76a91479091972186c449eb1ded22b78e40d009bdf008988ac",
+			value:      1000000000,
+			sighash:    "64f3b0f4dd2bb3aa1ce8566d220cc74dda9df97d8490cc81d89d735c92e59fb6",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tx, err := parseRawTx(unhex(t, test.tx))
+			if err != nil {
+				t.Fatal(err)
+			}
+			sighash := tx.sighashSegwitV0(test.index, unhex(t, test.scriptCode), test.value)
+			if got := hex.EncodeToString(sighash); got != test.sighash {
+				t.Fatalf("got sighash %s, expected %s", got, test.sighash)
+			}
+			if test.signature == "" {
+				return
+			}
+			pubkey, err := btcec.ParsePubKey(unhex(t, test.pubkey))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// verifyECDSA takes the compact 64 byte encoding returned by the device. r and s of the DER
+			// signature are both 32 bytes long.
+			der := unhex(t, test.signature)
+			compact := append(append([]byte{}, der[4:36]...), der[38:70]...)
+			if !verifyECDSA(compact, sighash, pubkey) {
+				t.Error("signature does not verify")
+			}
+			compact[0] ^= 1
+			if verifyECDSA(compact, sighash, pubkey) {
+				t.Error("modified signature verifies")
+			}
+		})
+	}
+}
+
+func TestSighashTaproot(t *testing.T) {
+	tx, err := parseRawTx(unhex(t, bip143UnsignedTx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prevOuts := func(value uint64) []*rawTxOut {
+		return []*rawTxOut{
+			{Value: 625000000, PkScript: unhex(t, "5120a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c")},
+			{Value: value, PkScript: unhex(t, "5120a82f29944d65b86ae6b5e5cc75e294ead6c59391a1edc5e016e3498c67fc7bbb")},
+		}
+	}
+	sighash := tx.sighashTaproot(0, prevOuts(600000000))
+	if len(sighash) != 32 {
+		t.Fatalf("got %d byte sighash", len(sighash))
+	}
+	// BIP341 signatures commit to the amounts of all inputs, not only the one being signed.
+	if bytes.Equal(sighash, tx.sighashTaproot(0, prevOuts(600000001))) {
+		t.Error("sighash does not commit to the amount of the other input")
+	}
+	if bytes.Equal(sighash, tx.sighashTaproot(1, prevOuts(600000000))) {
+		t.Error("sighash does not commit to the input index")
+	}
+}
+
+func TestVerifySchnorr(t *testing.T) {
+	// BIP340 test vector 0.
+	pubkey := unhex(t, "f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9")
+	signature := unhex(t, "e907831f80848d1069a5371b402410364bdf1c5f8307b0084c55f1ce2dca821525f66a4a85ea8b71e482a74f382d2ce5ebeee8fdb2172f477df4900d310536c0")
+	message := make([]byte, 32)
+	if !verifySchnorr(signature, message, pubkey) {
+		t.Error("signature does not verify")
+	}
+	message[0] = 1
+	if verifySchnorr(signature, message, pubkey) {
+		t.Error("signature verifies for a different message")
+	}
+}
+
+func TestTaprootOutputKey(t *testing.T) {
+	// BIP86 test vectors of account 0.
+	tests := []struct {
+		internalKey string
+		outputKey   string
+	}{
+		{
+			"cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115",
+			"a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c",
+		},
+		{
+			"83dfe85a3151d2517290da461fe2815591ef69f2b18a2ce63f01697a8b313145",
+			"a82f29944d65b86ae6b5e5cc75e294ead6c59391a1edc5e016e3498c67fc7bbb",
+		},
+		{
+			"399f1b2f4393f29a18c937859c5dd8a77350103157eb880f02e8c08214277cef",
+			"882d74e5d0572d5a816cef0041a96b6c1de832f6f9676d9605c44d5e9a97d3dc",
+		},
+	}
+	for _, test := range tests {
+		// The output key only depends on the x coordinate of the internal key.
+		for _, prefix := range []string{"02", "03"} {
+			internalKey, err := btcec.ParsePubKey(unhex(t, prefix+test.internalKey))
+			if err != nil {
+				t.Fatal(err)
+			}
+			outputKey, err := taprootOutputKey(internalKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(outputKey); got != test.outputKey {
+				t.Errorf("got output key %s, expected %s", got, test.outputKey)
+			}
+		}
+	}
+}
+
+func TestAccountXPubPubkey(t *testing.T) {
+	// BIP32 test vector 1, chain m/0H/1 derived from the xpub of m/0H.
+	xpub, err := firmware.NewXPub("xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := &accountXPub{keypath: []uint32{0 + hardenedKeyStart}, xpub: xpub}
+	pubkey, err := account.pubkey([]uint32{0 + hardenedKeyStart, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "03501e454bf00751f24b1b489aa925215d66af2234e3891c3b21a52bedb3cd711c"
+	if got := hex.EncodeToString(pubkey.SerializeCompressed()); got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	for _, keypath := range [][]uint32{
+		{1 + hardenedKeyStart, 1},
+		{},
+		{0 + hardenedKeyStart, 1 + hardenedKeyStart},
+	} {
+		if _, err := account.pubkey(keypath); err == nil {
+			t.Errorf("pubkey(%v): expected an error", keypath)
+		}
+	}
+}
+
+func TestDERSignature(t *testing.T) {
+	// From the BIP143 native P2WPKH example.
+	der := unhex(t, "304402203609e17b84f6a7d30c80bfa610b5b4542f32a8a0d5447a12fb1366d7f01cc44a0220573a954c4518331561406f90300e8f3358f51928d43c212a8caed02de67eebee")
+	compact := append(append([]byte{}, der[4:36]...), der[38:70]...)
+	if got := derSignature(compact); !bytes.Equal(got, der) {
+		t.Errorf("got %x", got)
+	}
+	// Integers with the high bit set are prefixed with a zero byte.
+	compact[0] |= 0x80
+	if got := derSignature(compact); got[3] != 33 || got[4] != 0 {
+		t.Errorf("got %x", got)
+	}
+}
+
+// signSimpleTx signs all inputs of tx the way the device does, returning 64 byte signatures.
+func signSimpleTx(t *testing.T, tx *simpleTx, privKey *btcec.PrivateKey) [][]byte {
+	t.Helper()
+	signatures := make([][]byte, len(tx.unsigned.Inputs))
+	for i := range signatures {
+		if tx.simpleType == messages.BTCScriptConfig_P2TR {
+			// BIP86: tweak the private key so that it matches the output key.
+			key := privKey.Key
+			if privKey.PubKey().SerializeCompressed()[0] == 0x03 {
+				key.Negate()
+			}
+			var tweak btcec.ModNScalar
+			tweak.SetByteSlice(chainhash.TaggedHash(
+				[]byte("TapTweak"), schnorr.SerializePubKey(privKey.PubKey()))[:])
+			key.Add(&tweak)
+			signature, err := schnorr.Sign(
+				btcec.PrivKeyFromScalar(&key), tx.unsigned.sighashTaproot(i, tx.prevOuts))
+			if err != nil {
+				t.Fatal(err)
+			}
+			signatures[i] = signature.Serialize()
+			continue
+		}
+		scriptCode, err := outputPkScript(messages.BTCOutputType_P2PKH, tx.pubkeyHash(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sighash := tx.unsigned.sighashSegwitV0(i, scriptCode, tx.prevOuts[i].Value)
+		signature, err := ecdsa.SignCompact(privKey, sighash, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Strip the recovery id.
+		signatures[i] = signature[1:]
+	}
+	return signatures
+}
+
+func TestSimpleTx(t *testing.T) {
+	privKey, pubkey := btcec.PrivKeyFromBytes(unhex(t, "0101010101010101010101010101010101010101010101010101010101010101"))
+	keypath := []uint32{84 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart}
+	account := &accountXPub{
+		keypath: keypath,
+		xpub:    &messages.XPub{PublicKey: pubkey.SerializeCompressed(), ChainCode: make([]byte, 32)},
+	}
+	tx := &firmware.BTCTx{
+		Version: 2,
+		Inputs: []*firmware.BTCTxInput{{
+			Input: &messages.BTCSignInputRequest{
+				PrevOutHash:  unhex(t, "fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f"),
+				PrevOutIndex: 1,
+				PrevOutValue: 100000,
+				Sequence:     0xfffffffd,
+				Keypath:      keypath,
+			},
+		}},
+		Outputs: []*messages.BTCSignOutputRequest{{
+			Type:    messages.BTCOutputType_P2WPKH,
+			Value:   90000,
+			Payload: unhex(t, "751e76e8199196d454941c45d1b3a323f1433bd6"),
+		}},
+		Locktime: 0,
+	}
+	tests := []struct {
+		simpleType  messages.BTCScriptConfig_SimpleType
+		scriptSig   bool
+		witnessSize int
+	}{
+		{messages.BTCScriptConfig_P2WPKH, false, 2},
+		{messages.BTCScriptConfig_P2WPKH_P2SH, true, 2},
+		{messages.BTCScriptConfig_P2TR, false, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.simpleType.String(), func(t *testing.T) {
+			simple, err := newSimpleTx(account, test.simpleType, tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			signatures := signSimpleTx(t, simple, privKey)
+			if err := simple.verify(signatures); err != nil {
+				t.Fatal(err)
+			}
+			signed, err := simple.signed(signatures)
+			if err != nil {
+				t.Fatal(err)
+			}
+			input := signed.Inputs[0]
+			if (len(input.ScriptSig) != 0) != test.scriptSig || len(input.Witness) != test.witnessSize {
+				t.Errorf("unexpected input: %+v", input)
+			}
+			// Signing native segwit inputs only adds witnesses, which the txid does not commit to.
+			if !test.scriptSig && !bytes.Equal(signed.txid(), simple.unsigned.txid()) {
+				t.Error("txid changed by signing")
+			}
+			if _, err := parseRawTx(signed.serialize(true)); err != nil {
+				t.Fatal(err)
+			}
+			result, err := simple.finalize(signatures)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result["tx"] != hex.EncodeToString(signed.serialize(true)) ||
+				result["txid"] != hashHex(signed.txid()) || result["wtxid"] != hashHex(signed.wtxid()) {
+				t.Errorf("unexpected result: %v", result)
+			}
+
+			signatures[0][10] ^= 1
+			if err := simple.verify(signatures); err == nil {
+				t.Error("expected an error for an invalid signature")
+			}
+			if _, err := simple.finalize(signatures); err == nil {
+				t.Error("expected finalize to fail for an invalid signature")
+			}
+			if err := simple.verify(nil); err == nil {
+				t.Error("expected an error for a missing signature")
+			}
+		})
+	}
+}
+
+func TestVerifySignatures(t *testing.T) {
+	xpub := func(pubkey *btcec.PublicKey) *messages.XPub {
+		return &messages.XPub{PublicKey: pubkey.SerializeCompressed(), ChainCode: make([]byte, 32)}
+	}
+	simplePrivKey, simplePubkey := btcec.PrivKeyFromBytes(unhex(t, "0101010101010101010101010101010101010101010101010101010101010101"))
+	multisigPrivKey, multisigPubkey := btcec.PrivKeyFromBytes(unhex(t, "0202020202020202020202020202020202020202020202020202020202020202"))
+	_, cosignerPubkey := btcec.PrivKeyFromBytes(unhex(t, "0303030303030303030303030303030303030303030303030303030303030303"))
+	simpleKeypath := []uint32{84 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart}
+	multisigKeypath := []uint32{48 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart, 2 + hardenedKeyStart}
+	multisig := newMultisigAccount(&messages.BTCScriptConfig_Multisig{
+		Threshold:    1,
+		Xpubs:        []*messages.XPub{xpub(cosignerPubkey), xpub(multisigPubkey)},
+		OurXpubIndex: 1,
+	}, multisigKeypath)
+	accounts := []btcAccount{
+		&simpleAccount{
+			xpub:       &accountXPub{keypath: simpleKeypath, xpub: xpub(simplePubkey)},
+			simpleType: messages.BTCScriptConfig_P2WPKH,
+		},
+		multisig,
+	}
+	tx := &firmware.BTCTx{
+		Version: 2,
+		Inputs: []*firmware.BTCTxInput{
+			{Input: &messages.BTCSignInputRequest{
+				PrevOutHash:  unhex(t, "fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f"),
+				PrevOutIndex: 1,
+				PrevOutValue: 100000,
+				Sequence:     0xfffffffd,
+				Keypath:      simpleKeypath,
+			}},
+			{Input: &messages.BTCSignInputRequest{
+				PrevOutHash:       unhex(t, "ef51e1b804cc89d182d279655c3aa89e815b1b309fe287d9b2b55d57b90ec68a"),
+				PrevOutIndex:      0,
+				PrevOutValue:      200000,
+				Sequence:          0xfffffffd,
+				Keypath:           multisigKeypath,
+				ScriptConfigIndex: 1,
+			}},
+		},
+		Outputs: []*messages.BTCSignOutputRequest{
+			{
+				Type:    messages.BTCOutputType_P2WPKH,
+				Value:   90000,
+				Payload: unhex(t, "751e76e8199196d454941c45d1b3a323f1433bd6"),
+			},
+			{
+				Ours:              true,
+				Value:             200000,
+				Keypath:           multisigKeypath,
+				ScriptConfigIndex: 1,
+			},
+		},
+	}
+	unsigned, prevOuts, err := unsignedTx(tx, func(index uint32, keypath []uint32) ([]byte, error) {
+		return accounts[index].pkScript(keypath)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptCode, err := outputPkScript(
+		messages.BTCOutputType_P2PKH, hash160(simplePubkey.SerializeCompressed()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	witnessScript, _, err := multisig.witnessScript(multisigKeypath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign := func(privKey *btcec.PrivateKey, sighash []byte) []byte {
+		signature, err := ecdsa.SignCompact(privKey, sighash, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signature[1:]
+	}
+	signatures := [][]byte{
+		sign(simplePrivKey, unsigned.sighashSegwitV0(0, scriptCode, prevOuts[0].Value)),
+		sign(multisigPrivKey, unsigned.sighashSegwitV0(1, witnessScript, prevOuts[1].Value)),
+	}
+	if err := verifySignatures(accounts, tx, signatures); err != nil {
+		t.Fatal(err)
+	}
+
+	// Signed by the cosigner's key instead of ours.
+	multisig.ourXPubIndex = 0
+	if err := verifySignatures(accounts, tx, signatures); err == nil {
+		t.Error("expected an error for a signature by another key")
+	}
+	multisig.ourXPubIndex = 1
+	signatures[0][10] ^= 1
+	if err := verifySignatures(accounts, tx, signatures); err == nil {
+		t.Error("expected an error for an invalid signature")
+	}
+	signatures[0][10] ^= 1
+	tx.Outputs[1].ScriptConfigIndex = 2
+	if err := verifySignatures(accounts, tx, signatures); err == nil {
+		t.Error("expected an error for an unknown scriptConfigIndex")
+	}
+}
+
+func TestVerifySignaturesPolicy(t *testing.T) {
+	privKey, pubkey := btcec.PrivKeyFromBytes(unhex(t, "0101010101010101010101010101010101010101010101010101010101010101"))
+	simpleKeypath := []uint32{84 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart}
+	policyKeypath := []uint32{48 + hardenedKeyStart, 0 + hardenedKeyStart, 0 + hardenedKeyStart, 3 + hardenedKeyStart}
+	scriptConfigs := []*messages.BTCScriptConfigWithKeypath{
+		{
+			ScriptConfig: firmware.NewBTCScriptConfigSimple(messages.BTCScriptConfig_P2WPKH),
+			Keypath:      simpleKeypath,
+		},
+		{
+			ScriptConfig: &messages.BTCScriptConfig{
+				Config: &messages.BTCScriptConfig_Policy_{
+					Policy: &messages.BTCScriptConfig_Policy{Policy: "wsh(pk(@0/**))"},
+				},
+			},
+			Keypath: policyKeypath,
+		},
+	}
+	accounts := []btcAccount{
+		&simpleAccount{
+			xpub: &accountXPub{
+				keypath: simpleKeypath,
+				xpub: &messages.XPub{
+					PublicKey: pubkey.SerializeCompressed(),
+					ChainCode: make([]byte, 32),
+				},
+			},
+			simpleType: messages.BTCScriptConfig_P2WPKH,
+		},
+		&policyAccount{},
+	}
+	tx := &firmware.BTCTx{
+		Version: 2,
+		Inputs: []*firmware.BTCTxInput{
+			{Input: &messages.BTCSignInputRequest{
+				PrevOutHash:  unhex(t, "fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f"),
+				PrevOutIndex: 1,
+				PrevOutValue: 100000,
+				Sequence:     0xfffffffd,
+				Keypath:      simpleKeypath,
+			}},
+		},
+		Outputs: []*messages.BTCSignOutputRequest{
+			{
+				Type:    messages.BTCOutputType_P2WPKH,
+				Value:   90000,
+				Payload: unhex(t, "751e76e8199196d454941c45d1b3a323f1433bd6"),
+			},
+		},
+	}
+	unsigned, prevOuts, err := unsignedTx(tx, func(_ uint32, keypath []uint32) ([]byte, error) {
+		return accounts[0].pkScript(keypath)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptCode, err := outputPkScript(
+		messages.BTCOutputType_P2PKH, hash160(pubkey.SerializeCompressed()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := ecdsa.SignCompact(
+		privKey, unsigned.sighashSegwitV0(0, scriptCode, prevOuts[0].Value), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signatures := [][]byte{signature[1:]}
+
+	// An unused policy account does not prevent verification.
+	if involvesPolicy(scriptConfigs, tx) {
+		t.Fatal("expected the transaction not to involve the policy account")
+	}
+	if err := verifySignatures(accounts, tx, signatures); err != nil {
+		t.Fatal(err)
+	}
+
+	// Spending a policy input along with the P2WPKH input.
+	tx.Inputs = append(tx.Inputs, &firmware.BTCTxInput{Input: &messages.BTCSignInputRequest{
+		PrevOutHash:       unhex(t, "ef51e1b804cc89d182d279655c3aa89e815b1b309fe287d9b2b55d57b90ec68a"),
+		PrevOutIndex:      0,
+		PrevOutValue:      200000,
+		Sequence:          0xfffffffd,
+		Keypath:           append(policyKeypath, 0, 0),
+		ScriptConfigIndex: 1,
+	}})
+	signatures = append(signatures, make([]byte, 64))
+	if !involvesPolicy(scriptConfigs, tx) {
+		t.Error("expected the transaction to involve the policy account")
+	}
+	if err := verifySignatures(accounts, tx, signatures); err != errPolicyUnverifiable {
+		t.Errorf("expected errPolicyUnverifiable, got %v", err)
+	}
+
+	// Sending change to a policy account.
+	tx.Inputs = tx.Inputs[:1]
+	signatures = signatures[:1]
+	tx.Outputs = append(tx.Outputs, &messages.BTCSignOutputRequest{
+		Ours:              true,
+		Value:             5000,
+		Keypath:           append(policyKeypath, 1, 0),
+		ScriptConfigIndex: 1,
+	})
+	if !involvesPolicy(scriptConfigs, tx) {
+		t.Error("expected the transaction to involve the policy account")
+	}
+	if err := verifySignatures(accounts, tx, signatures); err != errPolicyUnverifiable {
+		t.Errorf("expected errPolicyUnverifiable, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// AsyncSetPassword lets the user set a device password and seeds the keystore with seedLen bytes
+// of entropy (16 or 32). On success, the status changes to StatusSeeded.
+func (device *jsDevice) AsyncSetPassword(done func(*jsError), seedLen int) {
+	go func() {
+		done(toJSError(device.withSeedChange(func() error {
+			return device.device.SetPassword(seedLen)
+		})))
+	}()
+}
+
+// AsyncDeviceInfo returns the device info as an object with the keys "name", "version",
+// "initialized", "mnemonicPassphraseEnabled", "securechipModel" and
+// "monotonicIncrementsRemaining".
+func (device *jsDevice) AsyncDeviceInfo(done func(map[string]interface{}, *jsError)) {
+	go func() {
+		info, err := device.device.DeviceInfo()
+		if err != nil {
+			done(nil, toJSError(err))
+			return
+		}
+		done(map[string]interface{}{
+			"name":                         info.Name,
+			"version":                      info.Version,
+			"initialized":                  info.Initialized,
+			"mnemonicPassphraseEnabled":    info.MnemonicPassphraseEnabled,
+			"securechipModel":              info.SecurechipModel,
+			"monotonicIncrementsRemaining": info.MonotonicIncrementsRemaining,
+		}, nil)
+	}()
+}
+
+// AsyncSetDeviceName sets the device name. The user is asked to confirm the name on the device.
+func (device *jsDevice) AsyncSetDeviceName(done func(*jsError), name string) {
+	go func() {
+		done(toJSError(device.device.SetDeviceName(name)))
+	}()
+}
+
+// deviceLanguages are the locale codes supported by the firmware, exported as
+// constants.DeviceLanguages.
+var deviceLanguages = []string{
+	"ar", "bg", "de", "en", "es", "fa", "fr", "he", "hi", "id",
+	"it", "ja", "ko", "ms", "nl", "pt", "ru", "sl", "tr", "zh",
+}
+
+// isDeviceLanguage checks that language is one of deviceLanguages.
+func isDeviceLanguage(language string) bool {
+	for _, deviceLanguage := range deviceLanguages {
+		if language == deviceLanguage {
+			return true
+		}
+	}
+	return false
+}
+
+// AsyncSetDeviceLanguage sets the device language. language must be one of
+// constants.DeviceLanguages, e.g. "de".
+func (device *jsDevice) AsyncSetDeviceLanguage(done func(*jsError), language string) {
+	go func() {
+		if !isDeviceLanguage(language) {
+			done(toJSError(fmt.Errorf("unsupported device language: %q", language)))
+			return
+		}
+		done(toJSError(device.device.SetDeviceLanguage(language)))
+	}()
+}
+
+// AsyncRootFingerprint returns the keypath fingerprint of the root public key as a hex string
+// (4 bytes), used in key origin info like "[fingerprint/84'/0'/0']xpub...".
+func (device *jsDevice) AsyncRootFingerprint(done func(string, *jsError)) {
+	go func() {
+		fingerprint, err := device.device.RootFingerprint()
+		if err != nil {
+			done("", toJSError(err))
+			return
+		}
+		done(hex.EncodeToString(fingerprint), nil)
+	}()
+}
+
+// AsyncReset factory-resets the device after the user confirms on the device. The device reboots
+// afterwards, closing the connection.
+func (device *jsDevice) AsyncReset(done func(*jsError)) {
+	go func() {
+		done(toJSError(device.withSeedChange(device.device.Reset)))
+	}()
+}
+
+// AsyncGotoStartupSettings reboots the device into the startup settings menu after the user
+// confirms on the device, closing the connection.
+func (device *jsDevice) AsyncGotoStartupSettings(done func(*jsError)) {
+	go func() {
+		done(toJSError(device.device.GotoStartupSettings()))
+	}()
+}
+
+// AsyncUpgradeFirmware reboots the device into the bootloader after the user confirms on the
+// device, closing the connection.
+func (device *jsDevice) AsyncUpgradeFirmware(done func(*jsError)) {
+	go func() {
+		done(toJSError(device.device.UpgradeFirmware()))
+	}()
+}
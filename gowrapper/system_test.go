@@ -0,0 +1,39 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "testing"
+
+func TestIsDeviceLanguage(t *testing.T) {
+	tests := []struct {
+		language string
+		valid    bool
+	}{
+		{"de", true},
+		{"en", true},
+		{"zh", true},
+		{"", false},
+		{"xx", false},
+		{"xx_YY", false},
+		{"DE", false},
+		{"de_CH", false},
+		{"en\x00", false},
+	}
+	for _, test := range tests {
+		if got := isDeviceLanguage(test.language); got != test.valid {
+			t.Errorf("isDeviceLanguage(%q) = %v, expected %v", test.language, got, test.valid)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+	"github.com/digitalbitbox/bitbox02-api-go/util/semver"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// bip86Purpose is the purpose of single-sig P2TR accounts, m/86'/<coin>'/<account>'.
+const bip86Purpose = 86 + hardenedKeyStart
+
+// bip44CoinTypes are the BIP44 coin types of the supported coins.
+var bip44CoinTypes = map[messages.BTCCoin]uint32{
+	messages.BTCCoin_BTC:  0 + hardenedKeyStart,
+	messages.BTCCoin_TBTC: 1 + hardenedKeyStart,
+	messages.BTCCoin_LTC:  2 + hardenedKeyStart,
+	messages.BTCCoin_TLTC: 1 + hardenedKeyStart,
+}
+
+// bip86CoinType returns the BIP44 coin type of a coin supporting taproot, which Litecoin does not.
+func bip86CoinType(coin messages.BTCCoin) (uint32, bool) {
+	switch coin {
+	case messages.BTCCoin_BTC, messages.BTCCoin_TBTC:
+		return bip44CoinTypes[coin], true
+	default:
+		return 0, false
+	}
+}
+
+func (device *jsDevice) checkTaprootSupported() error {
+	if !device.device.Version().AtLeast(semver.NewSemVer(9, 10, 0)) {
+		return firmware.UnsupportedError("9.10.0")
+	}
+	return nil
+}
+
+// validateBIP86Keypath checks that the keypath is a BIP86 account-level keypath
+// m/86'/<coin>'/<account>', or an address-level keypath m/86'/<coin>'/<account>'/<change>/<address>
+// if addressLevel is true.
+func validateBIP86Keypath(coin messages.BTCCoin, keypath []uint32, addressLevel bool) error {
+	coinType, ok := bip86CoinType(coin)
+	if !ok {
+		return fmt.Errorf("taproot is not supported for %s", coin)
+	}
+	expected := fmt.Sprintf("m/86'/%d'/<account>'", coinType-hardenedKeyStart)
+	expectedLen := 3
+	if addressLevel {
+		expected += "/<change>/<address>"
+		expectedLen = 5
+	}
+	errInvalid := fmt.Errorf("invalid taproot keypath, expected %s", expected)
+	if len(keypath) != expectedLen ||
+		keypath[0] != bip86Purpose ||
+		keypath[1] != coinType ||
+		keypath[2] < hardenedKeyStart {
+		return errInvalid
+	}
+	if addressLevel && (keypath[3] > 1 || keypath[4] >= hardenedKeyStart) {
+		return errInvalid
+	}
+	return nil
+}
+
+// bip86AccountKeypath returns the BIP86 account-level keypath m/86'/<coin>'/<account>'. account must
+// be a non-negative integer below the hardened offset.
+func bip86AccountKeypath(coin messages.BTCCoin, account *js.Object) ([]interface{}, *jsError) {
+	coinType, ok := bip86CoinType(coin)
+	if !ok {
+		return nil, toJSError(fmt.Errorf("taproot is not supported for %s", coin))
+	}
+	// JavaScript numbers are float64, anything else (e.g. strings) is rejected.
+	accountNumber, ok := account.Interface().(float64)
+	if !ok || accountNumber != math.Trunc(accountNumber) ||
+		accountNumber < 0 || accountNumber >= hardenedKeyStart {
+		return nil, toJSError(errors.New("invalid account"))
+	}
+	return jsKeypath([]uint32{bip86Purpose, coinType, uint32(accountNumber) + hardenedKeyStart}), nil
+}
+
+// isBIP86Keypath returns true if the keypath is a BIP86 account-level keypath
+// m/86'/<coin>'/<account>' or address-level keypath m/86'/<coin>'/<account>'/<change>/<address>.
+func isBIP86Keypath(coin messages.BTCCoin, keypath []uint32) bool {
+	return validateBIP86Keypath(coin, keypath, false) == nil ||
+		validateBIP86Keypath(coin, keypath, true) == nil
+}
+
+// checkTaprootKeypaths checks that the keypaths of the P2TR accounts in scriptConfigs are BIP86
+// account-level keypaths, and that the keypaths of the inputs and change outputs of these accounts
+// are BIP86 address-level keypaths below their account.
+func checkTaprootKeypaths(
+	coin messages.BTCCoin,
+	scriptConfigs []*messages.BTCScriptConfigWithKeypath,
+	tx *firmware.BTCTx,
+) error {
+	isTaproot := func(scriptConfigIndex uint32) bool {
+		return int(scriptConfigIndex) < len(scriptConfigs) &&
+			scriptConfigs[scriptConfigIndex].ScriptConfig.GetSimpleType() ==
+				messages.BTCScriptConfig_P2TR
+	}
+	checkKeypath := func(scriptConfigIndex uint32, keypath []uint32) error {
+		if err := validateBIP86Keypath(coin, keypath, true); err != nil {
+			return err
+		}
+		for i, element := range scriptConfigs[scriptConfigIndex].Keypath {
+			if keypath[i] != element {
+				return errors.New("taproot keypath does not belong to the account")
+			}
+		}
+		return nil
+	}
+	for i, scriptConfig := range scriptConfigs {
+		if !isTaproot(uint32(i)) {
+			continue
+		}
+		if err := validateBIP86Keypath(coin, scriptConfig.Keypath, false); err != nil {
+			return err
+		}
+	}
+	for _, input := range tx.Inputs {
+		if !isTaproot(input.Input.ScriptConfigIndex) {
+			continue
+		}
+		if err := checkKeypath(input.Input.ScriptConfigIndex, input.Input.Keypath); err != nil {
+			return err
+		}
+	}
+	for _, output := range tx.Outputs {
+		if !output.Ours || !isTaproot(output.ScriptConfigIndex) {
+			continue
+		}
+		if err := checkKeypath(output.ScriptConfigIndex, output.Keypath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+)
+
+func TestCheckTaprootKeypaths(t *testing.T) {
+	const h = hardenedKeyStart
+	scriptConfigs := []*messages.BTCScriptConfigWithKeypath{
+		{
+			ScriptConfig: firmware.NewBTCScriptConfigSimple(messages.BTCScriptConfig_P2WPKH),
+			Keypath:      []uint32{84 + h, 0 + h, 0 + h},
+		},
+		{
+			ScriptConfig: firmware.NewBTCScriptConfigSimple(messages.BTCScriptConfig_P2TR),
+			Keypath:      []uint32{86 + h, 0 + h, 0 + h},
+		},
+	}
+	tx := func(inputKeypath, changeKeypath []uint32) *firmware.BTCTx {
+		return &firmware.BTCTx{
+			Inputs: []*firmware.BTCTxInput{
+				{Input: &messages.BTCSignInputRequest{Keypath: []uint32{84 + h, 0 + h, 0 + h, 0, 0}}},
+				{Input: &messages.BTCSignInputRequest{Keypath: inputKeypath, ScriptConfigIndex: 1}},
+			},
+			Outputs: []*messages.BTCSignOutputRequest{
+				{Ours: true, Keypath: changeKeypath, ScriptConfigIndex: 1},
+			},
+		}
+	}
+	valid := []uint32{86 + h, 0 + h, 0 + h, 0, 5}
+	change := []uint32{86 + h, 0 + h, 0 + h, 1, 3}
+	if err := checkTaprootKeypaths(messages.BTCCoin_BTC, scriptConfigs, tx(valid, change)); err != nil {
+		t.Fatal(err)
+	}
+	otherAccount := []uint32{86 + h, 0 + h, 1 + h, 0, 5}
+	if checkTaprootKeypaths(messages.BTCCoin_BTC, scriptConfigs, tx(otherAccount, change)) == nil {
+		t.Error("expected an error for an input of another account")
+	}
+	if checkTaprootKeypaths(messages.BTCCoin_BTC, scriptConfigs, tx(valid, otherAccount)) == nil {
+		t.Error("expected an error for change to another account")
+	}
+	notBIP86 := []uint32{84 + h, 0 + h, 0 + h, 0, 5}
+	if checkTaprootKeypaths(messages.BTCCoin_BTC, scriptConfigs, tx(notBIP86, change)) == nil {
+		t.Error("expected an error for a non-BIP86 input keypath")
+	}
+	if checkTaprootKeypaths(messages.BTCCoin_LTC, scriptConfigs, tx(valid, change)) == nil {
+		t.Error("expected an error for a coin without taproot")
+	}
+	scriptConfigs[1].Keypath = []uint32{84 + h, 0 + h, 0 + h}
+	if checkTaprootKeypaths(messages.BTCCoin_BTC, scriptConfigs, tx(valid, change)) == nil {
+		t.Error("expected an error for a non-BIP86 account keypath")
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package firmware
+
+import (
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+	"github.com/digitalbitbox/bitbox02-api-go/util/errp"
+)
+
+// ElectrumEncryptionKey returns the xpub at the given keypath, which is used by Electrum to
+// derive the wallet file encryption key. The keypath has to be m/4541509'/1112098098'.
+func (device *Device) ElectrumEncryptionKey(keypath []uint32) (string, error) {
+	request := &messages.Request{
+		Request: &messages.Request_ElectrumEncryptionKey{
+			ElectrumEncryptionKey: &messages.ElectrumEncryptionKeyRequest{
+				Keypath: keypath,
+			},
+		},
+	}
+	response, err := device.query(request)
+	if err != nil {
+		return "", err
+	}
+	electrumResponse, ok := response.Response.(*messages.Response_ElectrumEncryptionKey)
+	if !ok {
+		return "", errp.New("unexpected response")
+	}
+	return electrumResponse.ElectrumEncryptionKey.Key, nil
+}
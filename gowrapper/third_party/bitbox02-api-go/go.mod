@@ -0,0 +1,11 @@
+module github.com/digitalbitbox/bitbox02-api-go
+
+go 1.14
+
+require (
+	github.com/btcsuite/btcd/btcec/v2 v2.3.2
+	github.com/btcsuite/btcd/btcutil v1.1.3
+	github.com/flynn/noise v1.0.0
+	github.com/pkg/errors v0.8.1
+	google.golang.org/protobuf v1.28.1
+)
@@ -0,0 +1,456 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware"
+	"github.com/digitalbitbox/bitbox02-api-go/api/firmware/messages"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// rawTxIn is a transaction input in the Bitcoin wire format.
+type rawTxIn struct {
+	// PrevOutHash is the txid of the spent output, in the internal byte order (reversed compared to
+	// the hex txid shown in block explorers).
+	PrevOutHash  []byte
+	PrevOutIndex uint32
+	ScriptSig    []byte
+	Sequence     uint32
+	Witness      [][]byte
+}
+
+// rawTxOut is a transaction output in the Bitcoin wire format.
+type rawTxOut struct {
+	Value    uint64
+	PkScript []byte
+}
+
+// rawTx is a Bitcoin transaction, see
+// https://github.com/bitcoin/bips/blob/master/bip-0144.mediawiki#serialization.
+type rawTx struct {
+	Version  uint32
+	Inputs   []*rawTxIn
+	Outputs  []*rawTxOut
+	Locktime uint32
+}
+
+// txReader reads Bitcoin wire format primitives.
+type txReader struct {
+	*bytes.Reader
+}
+
+func newTxReader(b []byte) *txReader {
+	return &txReader{bytes.NewReader(b)}
+}
+
+func (r *txReader) readUint32() (uint32, error) {
+	var v uint32
+	err := binary.Read(r, binary.LittleEndian, &v)
+	return v, err
+}
+
+func (r *txReader) readUint64() (uint64, error) {
+	var v uint64
+	err := binary.Read(r, binary.LittleEndian, &v)
+	return v, err
+}
+
+func (r *txReader) readCompactSize() (uint64, error) {
+	prefix, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	switch prefix {
+	case 0xfd:
+		var v uint16
+		err := binary.Read(r, binary.LittleEndian, &v)
+		return uint64(v), err
+	case 0xfe:
+		v, err := r.readUint32()
+		return uint64(v), err
+	case 0xff:
+		return r.readUint64()
+	default:
+		return uint64(prefix), nil
+	}
+}
+
+func (r *txReader) readBytes(n uint64) ([]byte, error) {
+	if n > uint64(r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := make([]byte, n)
+	_, err := io.ReadFull(r, b)
+	return b, err
+}
+
+func (r *txReader) readVarBytes() ([]byte, error) {
+	n, err := r.readCompactSize()
+	if err != nil {
+		return nil, err
+	}
+	return r.readBytes(n)
+}
+
+func writeCompactSize(buf *bytes.Buffer, n uint64) {
+	switch {
+	case n < 0xfd:
+		buf.WriteByte(byte(n))
+	case n <= 0xffff:
+		buf.WriteByte(0xfd)
+		_ = binary.Write(buf, binary.LittleEndian, uint16(n))
+	case n <= 0xffffffff:
+		buf.WriteByte(0xfe)
+		_ = binary.Write(buf, binary.LittleEndian, uint32(n))
+	default:
+		buf.WriteByte(0xff)
+		_ = binary.Write(buf, binary.LittleEndian, n)
+	}
+}
+
+func writeVarBytes(buf *bytes.Buffer, b []byte) {
+	writeCompactSize(buf, uint64(len(b)))
+	buf.Write(b)
+}
+
+func readTxOut(r *txReader) (*rawTxOut, error) {
+	value, err := r.readUint64()
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := r.readVarBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &rawTxOut{Value: value, PkScript: pkScript}, nil
+}
+
+func (out *rawTxOut) serialize(buf *bytes.Buffer) {
+	_ = binary.Write(buf, binary.LittleEndian, out.Value)
+	writeVarBytes(buf, out.PkScript)
+}
+
+// parseRawTx parses a transaction in the Bitcoin wire format, with or without witnesses.
+func parseRawTx(raw []byte) (*rawTx, error) {
+	tx, err := readRawTx(newTxReader(raw))
+	if err != nil {
+		return nil, errors.New("could not parse transaction: " + err.Error())
+	}
+	return tx, nil
+}
+
+func readRawTx(r *txReader) (*rawTx, error) {
+	tx := &rawTx{}
+	var err error
+	if tx.Version, err = r.readUint32(); err != nil {
+		return nil, err
+	}
+	numInputs, err := r.readCompactSize()
+	if err != nil {
+		return nil, err
+	}
+	hasWitness := false
+	if numInputs == 0 {
+		// Segwit marker, followed by the flag.
+		flag, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if flag != 0x01 {
+			return nil, fmt.Errorf("invalid segwit flag: expected 0x01, got 0x%02x", flag)
+		}
+		hasWitness = true
+		if numInputs, err = r.readCompactSize(); err != nil {
+			return nil, err
+		}
+	}
+	// Each input takes at least 41 bytes.
+	if numInputs > uint64(r.Len())/41 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	tx.Inputs = make([]*rawTxIn, numInputs)
+	for i := range tx.Inputs {
+		input := &rawTxIn{}
+		if input.PrevOutHash, err = r.readBytes(32); err != nil {
+			return nil, err
+		}
+		if input.PrevOutIndex, err = r.readUint32(); err != nil {
+			return nil, err
+		}
+		if input.ScriptSig, err = r.readVarBytes(); err != nil {
+			return nil, err
+		}
+		if input.Sequence, err = r.readUint32(); err != nil {
+			return nil, err
+		}
+		tx.Inputs[i] = input
+	}
+	numOutputs, err := r.readCompactSize()
+	if err != nil {
+		return nil, err
+	}
+	// Each output takes at least 9 bytes.
+	if numOutputs > uint64(r.Len())/9 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	tx.Outputs = make([]*rawTxOut, numOutputs)
+	for i := range tx.Outputs {
+		if tx.Outputs[i], err = readTxOut(r); err != nil {
+			return nil, err
+		}
+	}
+	if hasWitness {
+		for _, input := range tx.Inputs {
+			numItems, err := r.readCompactSize()
+			if err != nil {
+				return nil, err
+			}
+			if numItems > uint64(r.Len()) {
+				return nil, io.ErrUnexpectedEOF
+			}
+			input.Witness = make([][]byte, numItems)
+			for j := range input.Witness {
+				if input.Witness[j], err = r.readVarBytes(); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if tx.Locktime, err = r.readUint32(); err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, errors.New("unexpected trailing data")
+	}
+	return tx, nil
+}
+
+func (tx *rawTx) hasWitness() bool {
+	for _, input := range tx.Inputs {
+		if len(input.Witness) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// serialize serializes the transaction in the Bitcoin wire format. Witnesses are included if
+// withWitness is true and at least one input has a witness.
+func (tx *rawTx) serialize(withWitness bool) []byte {
+	withWitness = withWitness && tx.hasWitness()
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.LittleEndian, tx.Version)
+	if withWitness {
+		buf.Write([]byte{0x00, 0x01})
+	}
+	writeCompactSize(&buf, uint64(len(tx.Inputs)))
+	for _, input := range tx.Inputs {
+		buf.Write(input.PrevOutHash)
+		_ = binary.Write(&buf, binary.LittleEndian, input.PrevOutIndex)
+		writeVarBytes(&buf, input.ScriptSig)
+		_ = binary.Write(&buf, binary.LittleEndian, input.Sequence)
+	}
+	writeCompactSize(&buf, uint64(len(tx.Outputs)))
+	for _, output := range tx.Outputs {
+		output.serialize(&buf)
+	}
+	if withWitness {
+		for _, input := range tx.Inputs {
+			writeCompactSize(&buf, uint64(len(input.Witness)))
+			for _, item := range input.Witness {
+				writeVarBytes(&buf, item)
+			}
+		}
+	}
+	_ = binary.Write(&buf, binary.LittleEndian, tx.Locktime)
+	return buf.Bytes()
+}
+
+func doubleSHA256(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
+
+func hash160(b []byte) []byte {
+	sha := sha256.Sum256(b)
+	h := ripemd160.New()
+	h.Write(sha[:])
+	return h.Sum(nil)
+}
+
+// txid returns the transaction id in the internal byte order, as used in PrevOutHash.
+func (tx *rawTx) txid() []byte {
+	return doubleSHA256(tx.serialize(false))
+}
+
+// wtxid returns the witness transaction id (BIP141) in the internal byte order.
+func (tx *rawTx) wtxid() []byte {
+	return doubleSHA256(tx.serialize(true))
+}
+
+// hashHex returns the hex encoding of a txid or wtxid in the byte order shown in block explorers,
+// which is the reverse of the internal byte order.
+func hashHex(hash []byte) string {
+	reversed := make([]byte, len(hash))
+	for i, b := range hash {
+		reversed[len(hash)-1-i] = b
+	}
+	return hex.EncodeToString(reversed)
+}
+
+// toPrevTx converts the transaction to the format needed to stream it to the device when it is
+// referenced by an input.
+func (tx *rawTx) toPrevTx() *firmware.BTCPrevTx {
+	inputs := make([]*messages.BTCPrevTxInputRequest, len(tx.Inputs))
+	for i, input := range tx.Inputs {
+		inputs[i] = &messages.BTCPrevTxInputRequest{
+			PrevOutHash:     input.PrevOutHash,
+			PrevOutIndex:    input.PrevOutIndex,
+			SignatureScript: input.ScriptSig,
+			Sequence:        input.Sequence,
+		}
+	}
+	outputs := make([]*messages.BTCPrevTxOutputRequest, len(tx.Outputs))
+	for i, output := range tx.Outputs {
+		outputs[i] = &messages.BTCPrevTxOutputRequest{
+			Value:        output.Value,
+			PubkeyScript: output.PkScript,
+		}
+	}
+	return &firmware.BTCPrevTx{
+		Version:  tx.Version,
+		Inputs:   inputs,
+		Outputs:  outputs,
+		Locktime: tx.Locktime,
+	}
+}
+
+func isP2PKH(script []byte) bool {
+	return len(script) == 25 && script[0] == 0x76 && script[1] == 0xa9 && script[2] == 0x14 &&
+		script[23] == 0x88 && script[24] == 0xac
+}
+
+func isP2SH(script []byte) bool {
+	return len(script) == 23 && script[0] == 0xa9 && script[1] == 0x14 && script[22] == 0x87
+}
+
+func isP2WPKH(script []byte) bool {
+	return len(script) == 22 && script[0] == 0x00 && script[1] == 0x14
+}
+
+func isP2WSH(script []byte) bool {
+	return len(script) == 34 && script[0] == 0x00 && script[1] == 0x20
+}
+
+func isP2TR(script []byte) bool {
+	return len(script) == 34 && script[0] == 0x51 && script[1] == 0x20
+}
+
+// outputTypeAndPayload returns the output type and the hash or key committed to by a pubkey
+// script, in the format expected by the device for outputs that are not ours.
+func outputTypeAndPayload(pkScript []byte) (messages.BTCOutputType, []byte, error) {
+	switch {
+	case isP2PKH(pkScript):
+		return messages.BTCOutputType_P2PKH, pkScript[3:23], nil
+	case isP2SH(pkScript):
+		return messages.BTCOutputType_P2SH, pkScript[2:22], nil
+	case isP2WPKH(pkScript):
+		return messages.BTCOutputType_P2WPKH, pkScript[2:], nil
+	case isP2WSH(pkScript):
+		return messages.BTCOutputType_P2WSH, pkScript[2:], nil
+	case isP2TR(pkScript):
+		return messages.BTCOutputType_P2TR, pkScript[2:], nil
+	default:
+		return messages.BTCOutputType_UNKNOWN, nil, errors.New("unsupported output script")
+	}
+}
+
+// outputPkScript is the inverse of outputTypeAndPayload, returning the pubkey script of an output
+// given by its type and payload.
+func outputPkScript(outputType messages.BTCOutputType, payload []byte) ([]byte, error) {
+	switch {
+	case outputType == messages.BTCOutputType_P2PKH && len(payload) == 20:
+		return append(append([]byte{0x76, 0xa9, 0x14}, payload...), 0x88, 0xac), nil
+	case outputType == messages.BTCOutputType_P2SH && len(payload) == 20:
+		return append(append([]byte{0xa9, 0x14}, payload...), 0x87), nil
+	case outputType == messages.BTCOutputType_P2WPKH && len(payload) == 20:
+		return append([]byte{0x00, 0x14}, payload...), nil
+	case outputType == messages.BTCOutputType_P2WSH && len(payload) == 32:
+		return append([]byte{0x00, 0x20}, payload...), nil
+	case outputType == messages.BTCOutputType_P2TR && len(payload) == 32:
+		return append([]byte{0x51, 0x20}, payload...), nil
+	default:
+		return nil, errors.New("invalid output type or payload")
+	}
+}
+
+// pushData returns a script pushing the given data onto the stack.
+func pushData(data []byte) []byte {
+	var buf bytes.Buffer
+	switch {
+	case len(data) < 0x4c:
+		buf.WriteByte(byte(len(data)))
+	case len(data) <= 0xff:
+		buf.Write([]byte{0x4c, byte(len(data))})
+	default:
+		buf.WriteByte(0x4d)
+		_ = binary.Write(&buf, binary.LittleEndian, uint16(len(data)))
+	}
+	buf.Write(data)
+	return buf.Bytes()
+}
+
+// multisigScript returns the `<threshold> <pubkey>... <n> OP_CHECKMULTISIG` script of the given
+// compressed public keys, in the given order.
+func multisigScript(threshold int, pubkeys [][]byte) []byte {
+	const op1, opCheckMultisig = 0x51, 0xae
+	script := []byte{byte(op1 + threshold - 1)}
+	for _, pubkey := range pubkeys {
+		script = append(script, pushData(pubkey)...)
+	}
+	return append(script, byte(op1+len(pubkeys)-1), opCheckMultisig)
+}
+
+// parseMultisigScript parses a `<threshold> <pubkey>... <n> OP_CHECKMULTISIG` script with
+// compressed public keys.
+func parseMultisigScript(script []byte) (int, [][]byte, error) {
+	errInvalid := errors.New("unsupported multisig script")
+	const op1, op16, opCheckMultisig = 0x51, 0x60, 0xae
+	if len(script) < 3 || script[0] < op1 || script[0] > op16 {
+		return 0, nil, errInvalid
+	}
+	threshold := int(script[0]-op1) + 1
+	rest := script[1:]
+	var pubkeys [][]byte
+	for len(rest) >= 34 && rest[0] == 33 {
+		pubkeys = append(pubkeys, rest[1:34])
+		rest = rest[34:]
+	}
+	if len(rest) != 2 || rest[1] != opCheckMultisig ||
+		rest[0] < op1 || rest[0] > op16 || int(rest[0]-op1)+1 != len(pubkeys) ||
+		threshold > len(pubkeys) {
+		return 0, nil, errInvalid
+	}
+	return threshold, pubkeys, nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2023 Shift Crypto AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+)
+
+// Native P2WPKH example of BIP143, spending a P2PK and a P2WPKH output.
+const (
+	bip143UnsignedTx = "0100000002fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f0000000000eeffffffef51e1b804cc89d182d279655c3aa89e815b1b309fe287d9b2b55d57b90ec68a0100000000ffffffff02202cb206000000001976a9148280b37df378db99f66f85c95a783a76ac7a6d5988ac9093510d000000001976a9143bde42dbee7e4dbe6a21b2d50ce2f0167faa815988ac11000000"
+	bip143SignedTx   = "01000000000102fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f00000000494830450221008b9d1dc26ba6a9cb62127b02742fa9d754cd3bebf337f7a55d114c8e5cdd30be022040529b194ba3f9281a99f2b1c0a19c0489bc22ede944ccf4ecbab4cc618ef3ed01eeffffffef51e1b804cc89d182d279655c3aa89e815b1b309fe287d9b2b55d57b90ec68a0100000000ffffffff02202cb206000000001976a9148280b37df378db99f66f85c95a783a76ac7a6d5988ac9093510d000000001976a9143bde42dbee7e4dbe6a21b2d50ce2f0167faa815988ac000247304402203609e17b84f6a7d30c80bfa610b5b4542f32a8a0d5447a12fb1366d7f01cc44a0220573a954c4518331561406f90300e8f3358f51928d43c212a8caed02de67eebee0121025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee635711000000"
+	// P2WPKH-P2SH example of BIP143.
+	bip143UnsignedTxP2SH = "0100000001db6b1b20aa0fd7b23880be2ecbd4a98130974cf4748fb66092ac4d3ceb1a54770100000000feffffff02b8b4eb0b000000001976a914a457b684d7f0d539a46a45bbc043f35b59d0d96388ac0008af2f000000001976a914fd270b1ee6abcaea97fea7ad0402e8bd8ad6d77c88ac92040000"
+)
+
+func unhex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestParseRawTx(t *testing.T) {
+	tests := []struct {
+		name       string
+		raw        string
+		numInputs  int
+		numOutputs int
+		locktime   uint32
+		hasWitness bool
+	}{
+		{"unsigned", bip143UnsignedTx, 2, 2, 0x11, false},
+		{"signed", bip143SignedTx, 2, 2, 0x11, true},
+		{"unsigned p2sh", bip143UnsignedTxP2SH, 1, 2, 0x492, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			raw := unhex(t, test.raw)
+			tx, err := parseRawTx(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(tx.Inputs) != test.numInputs || len(tx.Outputs) != test.numOutputs {
+				t.Errorf("got %d inputs and %d outputs", len(tx.Inputs), len(tx.Outputs))
+			}
+			if tx.Locktime != test.locktime {
+				t.Errorf("got locktime %d, expected %d", tx.Locktime, test.locktime)
+			}
+			if tx.hasWitness() != test.hasWitness {
+				t.Errorf("got hasWitness %v", tx.hasWitness())
+			}
+			if serialized := tx.serialize(true); !bytes.Equal(serialized, raw) {
+				t.Errorf("round trip failed, got %x", serialized)
+			}
+		})
+	}
+}
+
+func TestRawTxWitness(t *testing.T) {
+	tx, err := parseRawTx(unhex(t, bip143SignedTx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	witness := tx.Inputs[1].Witness
+	if len(witness) != 2 ||
+		!bytes.Equal(witness[1], unhex(t, "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357")) {
+		t.Errorf("unexpected witness: %x", witness)
+	}
+	// The txid does not commit to the witness, the wtxid does.
+	stripped, err := parseRawTx(tx.serialize(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stripped.hasWitness() {
+		t.Error("expected no witness")
+	}
+	if !bytes.Equal(stripped.txid(), tx.txid()) {
+		t.Error("txid changed when stripping the witness")
+	}
+	if bytes.Equal(tx.txid(), tx.wtxid()) {
+		t.Error("expected txid and wtxid to differ")
+	}
+	if !bytes.Equal(stripped.txid(), stripped.wtxid()) {
+		t.Error("expected txid and wtxid of a tx without witness to be equal")
+	}
+}
+
+func TestParseRawTxErrors(t *testing.T) {
+	raw := unhex(t, bip143UnsignedTx)
+	tests := []struct {
+		name string
+		raw  []byte
+	}{
+		{"empty", nil},
+		{"truncated", raw[:len(raw)-1]},
+		{"trailing data", append(append([]byte{}, raw...), 0x00)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := parseRawTx(test.raw); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestParseRawTxSegwitFlag(t *testing.T) {
+	raw := unhex(t, bip143UnsignedTx)
+	for _, flag := range []byte{0x00, 0x02, 0xff} {
+		// Version, segwit marker and flag, followed by the rest of the transaction.
+		withFlag := append(append(append([]byte{}, raw[:4]...), 0x00, flag), raw[4:]...)
+		_, err := parseRawTx(withFlag)
+		expected := fmt.Sprintf(
+			"could not parse transaction: invalid segwit flag: expected 0x01, got 0x%02x", flag)
+		if err == nil || err.Error() != expected {
+			t.Errorf("flag 0x%02x: expected error %q, got %v", flag, expected, err)
+		}
+	}
+}
+
+func TestCompactSize(t *testing.T) {
+	tests := []struct {
+		n       uint64
+		encoded string
+	}{
+		{0, "00"},
+		{0xfc, "fc"},
+		{0xfd, "fdfd00"},
+		{0xffff, "fdffff"},
+		{0x10000, "fe00000100"},
+		{0xffffffff, "feffffffff"},
+		{0x100000000, "ff0000000001000000"},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		writeCompactSize(&buf, test.n)
+		if got := hex.EncodeToString(buf.Bytes()); got != test.encoded {
+			t.Errorf("writeCompactSize(%d) = %s, expected %s", test.n, got, test.encoded)
+		}
+		n, err := newTxReader(buf.Bytes()).readCompactSize()
+		if err != nil || n != test.n {
+			t.Errorf("readCompactSize(%s) = %d, %v", test.encoded, n, err)
+		}
+	}
+}
+
+func TestParseMultisigScript(t *testing.T) {
+	pubkey1 := "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	pubkey2 := "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357"
+	// 1-of-2: OP_1 <pubkey1> <pubkey2> OP_2 OP_CHECKMULTISIG
+	script := unhex(t, "5121"+pubkey1+"21"+pubkey2+"52ae")
+	threshold, pubkeys, err := parseMultisigScript(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if threshold != 1 || len(pubkeys) != 2 ||
+		!bytes.Equal(pubkeys[0], unhex(t, pubkey1)) || !bytes.Equal(pubkeys[1], unhex(t, pubkey2)) {
+		t.Errorf("got %d %x", threshold, pubkeys)
+	}
+	for _, invalid := range [][]byte{
+		script[:len(script)-1],
+		// Threshold above the number of keys.
+		append([]byte{0x53}, script[1:]...),
+		// Number of keys does not match.
+		append(append([]byte{}, script[:len(script)-2]...), 0x53, 0xae),
+	} {
+		if _, _, err := parseMultisigScript(invalid); err == nil {
+			t.Errorf("parseMultisigScript(%x): expected an error", invalid)
+		}
+	}
+}
+
+func TestToPrevTx(t *testing.T) {
+	tx, err := parseRawTx(unhex(t, bip143SignedTx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prevTx := tx.toPrevTx()
+	if prevTx.Version != 1 || prevTx.Locktime != 0x11 ||
+		len(prevTx.Inputs) != 2 || len(prevTx.Outputs) != 2 {
+		t.Fatalf("unexpected previous transaction: %+v", prevTx)
+	}
+	input := prevTx.Inputs[0]
+	if !bytes.Equal(input.PrevOutHash, tx.Inputs[0].PrevOutHash) || input.PrevOutIndex != 0 ||
+		!bytes.Equal(input.SignatureScript, tx.Inputs[0].ScriptSig) || input.Sequence != 0xffffffee {
+		t.Errorf("unexpected input: %+v", input)
+	}
+	output := prevTx.Outputs[1]
+	if output.Value != 223450000 ||
+		!bytes.Equal(output.PubkeyScript, unhex(t, "76a9143bde42dbee7e4dbe6a21b2d50ce2f0167faa815988ac")) {
+		t.Errorf("unexpected output: %+v", output)
+	}
+}
+
+func TestHashHex(t *testing.T) {
+	hash := unhex(t, "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+	expected := "1f1e1d1c1b1a191817161514131211100f0e0d0c0b0a09080706050403020100"
+	if got := hashHex(hash); got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestMultisigScript(t *testing.T) {
+	pubkeys := [][]byte{
+		unhex(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"),
+		unhex(t, "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357"),
+	}
+	script := multisigScript(1, pubkeys)
+	threshold, parsed, err := parseMultisigScript(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if threshold != 1 || len(parsed) != 2 ||
+		!bytes.Equal(parsed[0], pubkeys[0]) || !bytes.Equal(parsed[1], pubkeys[1]) {
+		t.Errorf("round trip failed: %d %x", threshold, parsed)
+	}
+	if _, _, err := parseMultisigScript(script[:len(script)-1]); err == nil {
+		t.Error("expected an error")
+	}
+}
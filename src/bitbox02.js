@@ -21,10 +21,210 @@ import { getKeypathFromString, getChainIDFromKeypath, getCoinFromChainId } from
 const api = bitbox02;
 export const constants = bitbox02.constants;
 export const isErrorAbort = bitbox02.IsErrorAbort;
+export const isErrorUnsupported = bitbox02.IsErrorUnsupported;
 export const HARDENED = 0x80000000;
 
 const webHID = 'WEBHID';
 
+/**
+ * # Finalize a fully signed PSBT and extract the signed transaction.
+ *
+ * Supported input types are P2WPKH, P2WPKH-P2SH, P2TR (key path) and P2WSH multisig. Does not
+ * require a connected device.
+ *
+ * @param psbtBase64 base64 encoded PSBT, e.g. as returned by `BitBox02API.btcSignPSBT()`.
+ * @return Object
+ *     {
+ *         "psbt": string, // base64 encoded finalized PSBT
+ *         "tx": string, // hex encoded signed transaction, ready for broadcast
+ *     }
+ */
+export function btcFinalizePSBT(psbtBase64) {
+    const [result, err] = api.BTCFinalizePSBT(psbtBase64);
+    if (err !== null) {
+        throw err;
+    }
+    return result;
+}
+
+/**
+ * # Build the signed transaction of a single-sig account from the signatures returned by the device.
+ *
+ * The signatures are verified against the account xpub. Does not require a connected device.
+ *
+ * @param coin same as in `BitBox02API.btcSignSimple()`.
+ * @param simpleType same as in `BitBox02API.btcSignSimple()`.
+ * @param xpub account-level xpub given in any format, e.g. as returned by `BitBox02API.btcXPub()`.
+ * @param keypathAccount same as in `BitBox02API.btcSignSimple()`.
+ * @param inputs same as in `BitBox02API.btcSignSimple()`.
+ * @param outputs same as in `BitBox02API.btcSignSimple()`.
+ * @param version same as in `BitBox02API.btcSignSimple()`.
+ * @param locktime same as in `BitBox02API.btcSignSimple()`.
+ * @param signatures the 64 byte signatures returned by `BitBox02API.btcSignSimple()`, one per input.
+ * @return Object
+ *     {
+ *         "tx": string, // hex encoded signed transaction, ready for broadcast
+ *         "txid": string, // hex, in the byte order shown in block explorers
+ *         "wtxid": string, // hex, in the byte order shown in block explorers
+ *     }
+ */
+export function btcFinalizeSimple(coin, simpleType, xpub, keypathAccount, inputs, outputs, version, locktime, signatures) {
+    setInputDefaults(inputs);
+    setOutputDefaults(outputs);
+    const [result, err] = api.BTCFinalizeSimple(
+        coin, simpleType, xpub, keypathAccount, inputs, outputs, version, locktime, signatures);
+    if (err !== null) {
+        throw err;
+    }
+    return result;
+}
+
+/**
+ * # Combine the signatures of the cosigners of a multisig account and build the signed transaction.
+ *
+ * Does not require a connected device.
+ *
+ * @param account same as in `BitBox02API.btcMaybeRegisterScriptConfig()`, with the xpubs of all cosigners.
+ * @param inputs same as in `BitBox02API.btcSignMultisig()`.
+ * @param outputs same as in `BitBox02API.btcSignMultisig()`.
+ * @param version same as in `BitBox02API.btcSignMultisig()`.
+ * @param locktime same as in `BitBox02API.btcSignMultisig()`.
+ * @param signatureSets array with one entry per cosigner, each being the array of 64 byte signatures
+ *     returned by `BitBox02API.btcSignMultisig()`, one per input. Inputs not signed by a cosigner can
+ *     be given as an empty Uint8Array. The signatures are verified, and each input needs
+ *     signatures from at least `account.threshold` cosigners.
+ * @return Object
+ *     {
+ *         "tx": string, // hex encoded signed transaction, ready for broadcast
+ *         "txid": string, // hex, in the byte order shown in block explorers
+ *         "wtxid": string, // hex, in the byte order shown in block explorers
+ *     }
+ */
+export function btcFinalizeMultisig(account, inputs, outputs, version, locktime, signatureSets) {
+    setMultisigDefaults(account);
+    setInputDefaults(inputs);
+    setOutputDefaults(outputs);
+    const [result, err] = api.BTCFinalizeMultisig(account, inputs, outputs, version, locktime, signatureSets);
+    if (err !== null) {
+        throw err;
+    }
+    return result;
+}
+
+/**
+ * # Derive a single-sig address from the account xpub.
+ *
+ * Use this to check that the address shown by `BitBox02API.btcDisplayAddressSimple()` matches the
+ * address generated by the wallet. Does not require a connected device.
+ *
+ * @param coin same as in `BitBox02API.btcDisplayAddressSimple()`.
+ * @param simpleType same as in `BitBox02API.btcDisplayAddressSimple()`.
+ * @param xpub account-level xpub given in any format, e.g. as returned by `BitBox02API.btcXPub()`.
+ * @param keypathAccount account-level keypath of the xpub, for example `getKeypathFromString("m/84'/0'/0'")`.
+ * @param keypath address-level keypath, usually `keypathAccount.concat([0, address])`.
+ * @return the address string.
+ */
+export function btcDeriveAddressSimple(coin, simpleType, xpub, keypathAccount, keypath) {
+    const [result, err] = api.BTCDeriveAddressSimple(coin, simpleType, xpub, keypathAccount, keypath);
+    if (err !== null) {
+        throw err;
+    }
+    return result;
+}
+
+/**
+ * # Derive a multisig address from the xpubs of the cosigners.
+ *
+ * Use this to check that the address shown by `BitBox02API.btcDisplayAddressMultisig()` matches
+ * the address generated by the wallet. Does not require a connected device.
+ *
+ * @param account same as in `BitBox02API.btcMaybeRegisterScriptConfig()`.
+ * @param keypath same as in `BitBox02API.btcDisplayAddressMultisig()`.
+ * @return the address string.
+ */
+export function btcDeriveAddressMultisig(account, keypath) {
+    setMultisigDefaults(account);
+    const [result, err] = api.BTCDeriveAddressMultisig(account, keypath);
+    if (err !== null) {
+        throw err;
+    }
+    return result;
+}
+
+/**
+ * # Parse a multisig wallet setup file.
+ *
+ * Supported are Coldcard/Sparrow multisig setup files (`Name: ...`, `Policy: 2 of 3`,
+ * `Derivation: ...`, `Format: ...` followed by `<root fingerprint>: <xpub>` lines), and
+ * `wsh(sortedmulti(...))` or `sh(wsh(sortedmulti(...)))` descriptors with key origins. The
+ * descriptor checksum is verified if present. Does not require a connected device.
+ *
+ * @param text contents of the setup file, or the descriptor.
+ * @return Object
+ *     {
+ *         "name": string, // empty if not part of the setup, e.g. for descriptors.
+ *         "threshold": number,
+ *         "scriptType": constants.messages.BTCScriptConfig_Multisig_ScriptType,
+ *         "keys": [
+ *             {
+ *                 "rootFingerprint": Uint8Array(4),
+ *                 "keypath": [number], // account-level keypath
+ *                 "xpub": string,
+ *             },
+ *         ],
+ *     }
+ */
+export function btcParseMultisigSetup(text) {
+    const [result, err] = api.BTCParseMultisigSetup(text);
+    if (err !== null) {
+        throw err;
+    }
+    return result;
+}
+
+/**
+ * # Create a multisig wallet setup file.
+ *
+ * Does not require a connected device.
+ *
+ * @param setup same as returned by `btcParseMultisigSetup()`.
+ * @param format "coldcard" for a Coldcard/Sparrow setup file, or "descriptor" for a
+ *     `wsh(sortedmulti(...))` descriptor (wrapped in `sh(...)` for P2WSH-P2SH) of the receive
+ *     addresses, with checksum.
+ * @return string
+ */
+export function btcFormatMultisigSetup(setup, format) {
+    setMultisigDefaults(setup);
+    const [result, err] = api.BTCFormatMultisigSetup(setup, format);
+    if (err !== null) {
+        throw err;
+    }
+    return result;
+}
+
+/**
+ * @param coin `constants.messages.BTCCoin.*`, for example `constants.messages.BTCCoin.BTC`.
+ * @param account account number, e.g. 0
+ * @returns BIP86 (single-sig P2TR) account-level keypath m/86'/<coin>'/<account>' as array
+ */
+export function getBIP86AccountKeypath(coin, account) {
+    const [keypath, err] = api.BIP86AccountKeypath(coin, account);
+    if (err !== null) {
+        throw err;
+    }
+    return keypath;
+}
+
+/**
+ * @param coin `constants.messages.BTCCoin.*`, for example `constants.messages.BTCCoin.BTC`.
+ * @param keypathArray keypath as an array of ints
+ * @returns true if the keypath is a BIP86 account-level keypath m/86'/<coin>'/<account>' or
+ * address-level keypath m/86'/<coin>'/<account>'/<change>/<address>
+ */
+export function isBIP86Keypath(coin, keypathArray) {
+    return api.IsBIP86Keypath(coin, keypathArray);
+}
+
 function sleep(ms) {
     return new Promise(resolve => setTimeout(resolve, ms));
 }
@@ -92,10 +292,137 @@ const setOutputDefaults = outputs =>  {
             type: 0,
             payload: new Uint8Array(0),
             keypath: [],
+            scriptConfigIndex: 0,
+            address: '',
         }, outputs[i]);
     }
 }
 
+const setPolicyKeyDefaults = keys =>  {
+    // Workaround for gopherjs: all fields must be set for Go to be able to parse the structure.
+    // Keys of other signers might come without key origin info.
+    for (let i = 0; i < keys.length; i++) {
+        keys[i] = Object.assign({
+            rootFingerprint: new Uint8Array(0),
+            keypath: [],
+        }, keys[i]);
+    }
+}
+
+const setMultisigDefaults = multisig => {
+    // Workaround for gopherjs: all fields must be set for Go to be able to parse the structure,
+    // even though some fields are optional some of the time.
+    if (multisig.scriptType === undefined) {
+        multisig.scriptType = constants.messages.BTCScriptConfig_Multisig_ScriptType.P2WSH;
+    }
+}
+
+const setInputDefaults = inputs =>  {
+    // Workaround for gopherjs: all fields must be set for Go to be able to parse the structure,
+    // even though some fields are optional some of the time.
+    for (let i = 0; i < inputs.length; i++) {
+        inputs[i] = Object.assign({
+            scriptConfigIndex: 0,
+            prevTxRaw: '',
+        }, inputs[i]);
+    }
+}
+
+// Opens a BitBoxBridge websocket connection to the device. Returns a promise resolving to a
+// connection object with three keys:
+// onWrite(bytes): send bytes
+// close():  close the connection
+// valid(): bool - is the connection still alive?
+function connectWebsocket(devicePath, onMessageCb, onClose) {
+    const socket = new WebSocket("ws://127.0.0.1:8178/api/v1/socket/" + devicePath);
+    return new Promise((resolve, reject) => {
+        socket.binaryType = 'arraybuffer';
+        socket.onmessage = event => { onMessageCb(new Uint8Array(event.data)); };
+        socket.onclose = event => {
+            onClose();
+        };
+        socket.onopen = function (event) {
+            resolve({
+                onWrite: bytes => {
+                    if (socket.readyState != WebSocket.OPEN) {
+                        console.error("attempted write to a closed socket");
+                        return;
+                    }
+                    socket.send(bytes);
+                },
+                close: () => socket.close(),
+                valid: () => {
+                    return socket.readyState == WebSocket.OPEN;
+                },
+            });
+        };
+        socket.onerror = function(event) {
+            reject("Your BitBox02 is busy");
+        };
+    });
+}
+
+// Lets the user choose a device using WebHID and opens it. Returns the same connection object as
+// `connectWebsocket()`, with the additional key `productName`, or null if no matching device was
+// chosen.
+//
+// isProductName(productName) filters out other products that might be in the list presented by the
+// browser.
+async function connectWebHID(onMessageCb, onClose, isProductName) {
+    const vendorId = 0x03eb;
+    const productId = 0x2403;
+    let device;
+    try {
+        const devices = await navigator.hid.requestDevice({filters: [{vendorId, productId}]});
+        const d = devices[0];
+        if (isProductName(d.productName)) {
+            device = d;
+        }
+    } catch (err) {
+        return null;
+    }
+    if (!device) {
+        return null;
+    }
+    await device.open();
+    const onInputReport = event => {
+        onMessageCb(new Uint8Array(event.data.buffer));
+    };
+    device.addEventListener("inputreport", onInputReport);
+    // The connection ends either by `close()` or by the device disconnecting, e.g. when it is
+    // unplugged or reboots. Either way, `onClose` is called only once.
+    let closed = false;
+    const onDisconnect = event => {
+        if (event.device === device) {
+            closeOnce();
+        }
+    };
+    const closeOnce = () => {
+        if (closed) {
+            return;
+        }
+        closed = true;
+        device.removeEventListener("inputreport", onInputReport);
+        navigator.hid.removeEventListener("disconnect", onDisconnect);
+        onClose();
+    };
+    navigator.hid.addEventListener("disconnect", onDisconnect);
+    return {
+        onWrite: bytes => {
+            if (!device.opened) {
+                console.error("attempted write to a closed HID connection");
+                return;
+            }
+            device.sendReport(0, bytes);
+        },
+        close: () => {
+            device.close().then(closeOnce);
+        },
+        valid: () => device.opened,
+        productName: device.productName,
+    };
+}
+
 export class BitBox02API {
     /**
      * @param devicePath See `getDevicePath()`.
@@ -108,98 +435,42 @@ export class BitBox02API {
         // valid(): bool - is the connection still alive?
         this.connection = null;
         this.onCloseCb = null;
-
-        if (navigator.hid) {
-            navigator.hid.addEventListener("disconnect", () => {
-                if (this.onCloseCb) {
-                    this.onCloseCb();
-                }
-            });
-        }
-    }
-
-    connectWebsocket = onMessageCb => {
-        const socket = new WebSocket("ws://127.0.0.1:8178/api/v1/socket/" + this.devicePath);
-        return new Promise((resolve, reject) => {
-            socket.binaryType = 'arraybuffer';
-            socket.onmessage = event => { onMessageCb(new Uint8Array(event.data)); };
-            socket.onclose = event => {
-                if (this.onCloseCb) {
-                    this.onCloseCb();
-                }
-            };
-            socket.onopen = function (event) {
-                resolve({
-                    onWrite: bytes => {
-                        if (socket.readyState != WebSocket.OPEN) {
-                            console.error("attempted write to a closed socket");
-                            return;
-                        }
-                        socket.send(bytes);
-                    },
-                    close: () => socket.close(),
-                    valid: () => {
-                        return socket.readyState == WebSocket.OPEN;
-                    },
-                });
-            };
-            socket.onerror = function(event) {
-                reject("Your BitBox02 is busy");
-            };
-        });
     }
 
-    connectWebHID = async (onMessageCb) => {
-        const vendorId = 0x03eb;
-        const productId = 0x2403;
-        let device;
-        try {
-            const devices = await navigator.hid.requestDevice({filters: [{vendorId, productId}]});
-            const d = devices[0];
-            // Filter out other products that might be in the list presented by the Browser.
-            if (d.productName.includes('BitBox02')) {
-                device = d;
-            }
-        } catch (err) {
-            return null;
+    // Called whenever the connection is closed, e.g. by `close()`, by unplugging the device or by a
+    // device reboot. Aborts pending device queries so their promises are rejected or resolved instead
+    // of hanging forever.
+    onClose = () => {
+        if (this.fw) {
+            this.fw.js.OnClose();
         }
-        if (!device) {
-            return null;
+        if (this.onCloseCb) {
+            this.onCloseCb();
         }
-        await device.open();
-        const onInputReport = event => {
-            onMessageCb(new Uint8Array(event.data.buffer));
-        };
-        device.addEventListener("inputreport", onInputReport);
-        return {
-            onWrite: bytes => {
-                if (!device.opened) {
-                    console.error("attempted write to a closed HID connection");
-                    return;
-                }
-                device.sendReport(0, bytes);
-            },
-            close: () => {
-                device.close().then(() => {
-                    device.removeEventListener("inputreport", onInputReport);
-                    if (this.onCloseCb) {
-                        this.onCloseCb();
-                    }
-                });
-            },
-            valid: () => device.opened,
-        };
     }
 
+    connectWebsocket = onMessageCb => connectWebsocket(this.devicePath, onMessageCb, this.onClose);
+
+    connectWebHID = onMessageCb => connectWebHID(
+        onMessageCb, this.onClose, productName => productName.includes('BitBox02'));
+
     /**
      * @param showPairingCb Callback that is used to show pairing code. Must not block.
      * @param userVerify Promise that should resolve once the user wants to continue.
      * @param handleAttastionCb Callback that should handle the bool attestation result. Must not block.
      * @param onCloseCb Callback that's called when the websocket connection is closed.
      * @param setStatusCb Callback that lets the API set the status received from the device.
+     * @param onPassphraseSettingChangedCb Optional callback that's called when the passphrase was enabled or
+     *                          disabled using `setMnemonicPassphraseEnabled()`. The setting takes effect the
+     *                          next time the device is unlocked. Must not block.
      * @return Promise that will resolve once the pairing is complete.
+     *
+     * After pairing, the status is either `constants.Status.Initialized` or
+     * `constants.Status.Uninitialized`. An uninitialized device can be set up using `setPassword()`,
+     * which moves it to `constants.Status.Seeded`, followed by `createBackup()`, which moves it to
+     * `constants.Status.Initialized`.
      */
-    async connect(showPairingCb, userVerify, handleAttestationCb, onCloseCb, setStatusCb) {
+    async connect(showPairingCb, userVerify, handleAttestationCb, onCloseCb, setStatusCb, onPassphraseSettingChangedCb) {
         this.onCloseCb = onCloseCb;
         const onMessage = bytes => {
             if (this.connectionValid()) {
@@ -239,6 +510,9 @@ export class BitBox02API {
             if (ev === constants.Event.AttestationCheckDone) {
                 handleAttestationCb(this.firmware().Attestation());
             }
+            if (ev === constants.Event.PassphraseSettingChanged && onPassphraseSettingChangedCb) {
+                onPassphraseSettingChangedCb();
+            }
             if (ev === constants.Event.StatusChanged && this.firmware().Status() === constants.Status.RequireFirmwareUpgrade) {
                 this.connection.close();
                 throw new Error('Firmware upgrade required');
@@ -247,10 +521,6 @@ export class BitBox02API {
                 this.connection.close();
                 throw new Error('Unsupported firmware');
             }
-            if (ev === constants.Event.StatusChanged && this.firmware().Status() === constants.Status.Uninitialized) {
-                this.connection.close();
-                throw new Error('Uninitialized');
-            }
         });
 
         await this.firmware().js.AsyncInit();
@@ -263,6 +533,7 @@ export class BitBox02API {
                 await this.firmware().js.AsyncChannelHashVerify(true);
                 break;
             case constants.Status.Initialized:
+            case constants.Status.Uninitialized:
                 // Pairing skipped.
                 break;
             default:
@@ -277,6 +548,207 @@ export class BitBox02API {
         return this.firmware().js.Version();
     }
 
+    /**
+     * # Get information about the device.
+     *
+     * @return Object
+     *     {
+     *         "name": string, // device name
+     *         "version": string, // firmware version, e.g. "v9.15.0"
+     *         "initialized": bool,
+     *         "mnemonicPassphraseEnabled": bool, // true if the optional BIP39 passphrase is enabled
+     *         "securechipModel": string, // "ATECC608A" or "ATECC608B", empty before firmware v9.6.0
+     *         "monotonicIncrementsRemaining": number,
+     *     }
+     */
+    async deviceInfo() {
+        return this.firmware().js.AsyncDeviceInfo();
+    }
+
+    /**
+     * # Set the device name. The user is asked to confirm the name on the device.
+     *
+     * @param name new device name, at most 64 bytes.
+     */
+    async setDeviceName(name) {
+        return this.firmware().js.AsyncSetDeviceName(name);
+    }
+
+    /**
+     * # Set the device language.
+     *
+     * @param language locale code, one of `constants.DeviceLanguages`, e.g. "de".
+     */
+    async setDeviceLanguage(language) {
+        return this.firmware().js.AsyncSetDeviceLanguage(language);
+    }
+
+    /**
+     * # Get the root fingerprint of the seed.
+     *
+     * Used to construct key origin info, e.g. `[fingerprint/84'/0'/0']xpub...` in output descriptors
+     * and PSBTs.
+     *
+     * @return string - 4 bytes in hex, e.g. "4c00739d".
+     */
+    async rootFingerprint() {
+        return this.firmware().js.AsyncRootFingerprint();
+    }
+
+    // --- Device setup methods ---
+
+    /**
+     * # Set the device password and create a new seed. Only possible if the device is uninitialized.
+     *
+     * The user is asked to enter and confirm the password on the device. On success, the status
+     * changes to `constants.Status.Seeded` and a backup must be created using `createBackup()`.
+     *
+     * @param seedLen Seed length in bytes, 32 for a 24 word mnemonic or 16 for a 12 word mnemonic
+     *                (supported from firmware v9.6.0). Defaults to 32.
+     */
+    async setPassword(seedLen = 32) {
+        return this.firmware().js.AsyncSetPassword(seedLen);
+    }
+
+    /**
+     * # Create a backup on the inserted microSD card.
+     *
+     * The device status must be `constants.Status.Seeded` or `constants.Status.Initialized`.
+     * If the device is seeded, the status changes to `constants.Status.Initialized` on success.
+     */
+    async createBackup() {
+        return this.firmware().js.AsyncCreateBackup();
+    }
+
+    /**
+     * # Show the recovery words on the device.
+     *
+     * The user is asked to write down the words and to confirm some of them afterwards.
+     * If the device is seeded, the status changes to `constants.Status.Initialized` on success.
+     */
+    async showMnemonic() {
+        return this.firmware().js.AsyncShowMnemonic();
+    }
+
+    /**
+     * # Restore a seed from recovery words entered on the device. Only possible if the device is uninitialized.
+     *
+     * The user enters the words and a new device password on the device. On success, the status
+     * changes to `constants.Status.Initialized`.
+     */
+    async restoreFromMnemonic() {
+        return this.firmware().js.AsyncRestoreFromMnemonic();
+    }
+
+    /**
+     * # Enable or disable the optional BIP39 passphrase. The user is asked to confirm on the device.
+     *
+     * The change takes effect the next time the device is unlocked. When the passphrase is enabled,
+     * the user enters it on the device after unlocking, which selects the (hidden) wallet.
+     * Until then, the device keeps working with the current wallet. On success,
+     * `onPassphraseSettingChangedCb` passed to `connect()` is called, e.g. to offer the user to replug
+     * the device to switch wallets.
+     *
+     * @param enabled true to enable the passphrase, false to disable it.
+     */
+    async setMnemonicPassphraseEnabled(enabled) {
+        return this.firmware().js.AsyncSetMnemonicPassphraseEnabled(enabled);
+    }
+
+    // --- End device setup methods ---
+
+    // --- Reboot methods ---
+
+    /**
+     * # Factory-reset the device. The user is asked to confirm on the device.
+     *
+     * All data including the seed is erased and the device reboots afterwards. The connection is closed
+     * and `onCloseCb` passed to `connect()` is called.
+     */
+    async reset() {
+        return this.firmware().js.AsyncReset();
+    }
+
+    /**
+     * # Reboot into the startup settings menu. The user is asked to confirm on the device.
+     *
+     * The connection is closed and `onCloseCb` passed to `connect()` is called.
+     */
+    async gotoStartupSettings() {
+        return this.firmware().js.AsyncGotoStartupSettings();
+    }
+
+    /**
+     * # Reboot into the bootloader to upgrade the firmware. The user is asked to confirm on the device.
+     *
+     * The connection is closed and `onCloseCb` passed to `connect()` is called.
+     */
+    async upgradeFirmware() {
+        return this.firmware().js.AsyncUpgradeFirmware();
+    }
+
+    // --- End reboot methods ---
+
+    // --- Backup methods ---
+
+    /**
+     * # List the backups stored on the inserted microSD card.
+     *
+     * @return Array of backup objects
+     *     {
+     *         "id": string,
+     *         "name": string, // device name at the time of the backup
+     *         "timestamp": number, // creation time in unix seconds
+     *     }
+     */
+    async listBackups() {
+        return this.firmware().js.AsyncListBackups();
+    }
+
+    /**
+     * # Check that the inserted microSD card contains a valid backup of the current seed.
+     *
+     * @param silent if false, the result is also shown on the device. Default false.
+     * @return the ID of the matching backup. Rejects if no matching backup is found.
+     */
+    async checkBackup(silent = false) {
+        return this.firmware().js.AsyncCheckBackup(silent);
+    }
+
+    /**
+     * # Restore a backup from the inserted microSD card. Only possible if the device is uninitialized.
+     *
+     * The user is asked to confirm and to set a new device password. On success, the status changes
+     * to `constants.Status.Initialized`.
+     *
+     * @param id ID of the backup to restore, see `listBackups()`.
+     */
+    async restoreBackup(id) {
+        return this.firmware().js.AsyncRestoreBackup(id);
+    }
+
+    /**
+     * # Check if a microSD card is inserted.
+     *
+     * @return true if a microSD card is inserted.
+     */
+    async checkSDCard() {
+        return this.firmware().js.AsyncCheckSDCard();
+    }
+
+    /**
+     * # Ask the user on the device to insert or remove the microSD card.
+     *
+     * @param action `constants.messages.InsertRemoveSDCardRequest_SDCardAction.*`, for example
+     *               `constants.messages.InsertRemoveSDCardRequest_SDCardAction.INSERT_CARD`.
+     * @return Promise that resolves once the card has been inserted or removed.
+     */
+    async insertRemoveSDCard(action) {
+        return this.firmware().js.AsyncInsertRemoveSDCard(action);
+    }
+
+    // --- End backup methods ---
+
     // --- Bitcoin methods ---
 
     /**
@@ -311,6 +783,46 @@ export class BitBox02API {
         );
     }
 
+    /**
+     * # Discover the used single-sig accounts of a script type, e.g. when restoring a wallet.
+     * # The xpubs of the accounts `m/<purpose>'/<coin>'/<account>'` are fetched without display for the account
+     * # indexes 0, 1, ..., and their receive and change addresses are scanned until `gapLimit` consecutive addresses
+     * # are unused. Discovery stops at the first account without used receive addresses, as described in BIP44.
+     *
+     * @param coin Coin to target - `constants.messages.BTCCoin.*`, for example `constants.messages.BTCCoin.BTC`.
+     * @param simpleType script type - `constants.messages.BTCScriptConfig_SimpleType.*`, which determines the purpose
+     *     of the keypaths: 49' for P2WPKH_P2SH, 84' for P2WPKH and 86' for P2TR.
+     * @param isUsed async (addresses: [string]) => [boolean] - called with batches of addresses, e.g. to query an indexer.
+     *     Must return whether each address was used, in the same order.
+     * @param options optional:
+     *     {
+     *         "gapLimit": number, // number of consecutive unused addresses ending the scan of a chain, default 20.
+     *                             // Between 1 and 10000. Discovery fails if more than 10000 addresses of a chain
+     *                             // would be scanned.
+     *         "maxAccounts": number, // maximum number of accounts to scan, default 20. Between 1 and 100.
+     *     }
+     * @return promise with the used accounts:
+     *     [
+     *         {
+     *             "account": number, // account index
+     *             "keypathAccount": [number],
+     *             "xpub": string,
+     *             "receive": { // same for "change"
+     *                 "usedAddresses": [{ "keypath": [number], "address": string }],
+     *                 "nextIndex": number, // index of the first address after the last used one
+     *             },
+     *         },
+     *     ]
+     */
+    async btcDiscoverAccounts(coin, simpleType, isUsed, options = {}) {
+        return this.firmware().js.AsyncBTCDiscoverAccounts(
+            coin,
+            simpleType,
+            Object.assign({ gapLimit: 20, maxAccounts: 20 }, options),
+            addresses => Promise.resolve().then(() => isUsed(addresses)),
+        );
+    }
+
     /**
      * # Sign a single-sig transaction.
      *
@@ -324,6 +836,10 @@ export class BitBox02API {
      *       "prevOutValue": string, // satoshis as a decimal string,
      *       "sequence": number, // usually 0xFFFFFFFF
      *       "keypath": [number], // usually keypathAccount.concat([change, address]),
+     *       // The previous transaction, either as an object with `version`, `inputs`, `outputs` and
+     *       // `locktime` in `prevTx`, or hex encoded in `prevTxRaw`.
+     *       "prevTx": object,
+     *       "prevTxRaw": string,
      *     }
      * @param outputs array of output objects, with each output being either regular output or a change output
      *    Change outputs:
@@ -340,9 +856,17 @@ export class BitBox02API {
      *            "payload": new Uint8Array(20) | new Uint8Array(32)
      *            "value": string, // satoshis as a decimal string,
      *        }
+     *    Regular outputs can also be given as an address instead of type and payload. base58check (P2PKH,
+     *    P2SH), bech32 (P2WPKH, P2WSH) and bech32m (P2TR) addresses of the network of `coin` are accepted:
+     *        {
+     *            "ours": false,
+     *            "address": string, // e.g. "bc1q..."
+     *            "value": string, // satoshis as a decimal string,
+     *        }
      * @param version Transaction version, usually 1 or 2.
      * @param locktime Transaction locktime, usually 0.
-     * @return Array of 64 byte signatures, one per input.
+     * @return Array of 64 byte signatures, one per input. The signatures are verified against the
+     *         account xpub before they are returned; an invalid signature results in an error.
      */
     async btcSignSimple(
         coin,
@@ -352,6 +876,7 @@ export class BitBox02API {
         outputs,
         version,
         locktime) {
+        setInputDefaults(inputs);
         setOutputDefaults(outputs);
         return this.firmware().js.AsyncBTCSignSimple(
             coin,
@@ -364,6 +889,38 @@ export class BitBox02API {
         );
     }
 
+    /**
+     * Sign a Bitcoin single-sig transaction and return the signed transaction, ready to be broadcast.
+     * Params are the same as in `btcSignSimple`.
+     * @return Object
+     *     {
+     *       "signatures": [Uint8Array(64)], // same as returned by `btcSignSimple`
+     *       "tx": string, // hex encoded signed transaction
+     *       "txid": string, // hex, in the byte order shown in block explorers
+     *       "wtxid": string, // hex, in the byte order shown in block explorers
+     *     }
+     */
+    async btcSignSimpleTx(
+        coin,
+        simpleType,
+        keypathAccount,
+        inputs,
+        outputs,
+        version,
+        locktime) {
+        setInputDefaults(inputs);
+        setOutputDefaults(outputs);
+        return this.firmware().js.AsyncBTCSignSimpleTx(
+            coin,
+            simpleType,
+            keypathAccount,
+            inputs,
+            outputs,
+            version,
+            locktime,
+        );
+    }
+
     /**
      * Sign a Bitcoin message on the device.
      * @param coin Coin to target - `constants.messages.BTCCoin.*`. Currenty must be `constants.messages.BTCCoin.BTC`.
@@ -394,7 +951,9 @@ export class BitBox02API {
      * # Register a multisig account on the device with a user chosen name. If it is already registered, this does nothing.
      * # A multisig account must be registered before it can be used to show multisig addresses or sign multisig transactions.
      * # Note:
-     * # Currently, only P2WSH (bech32) multisig accounts on the keypath `m/48'/<coin>'/<account>'/2'` are supported.
+     * # Supported are P2WSH (bech32) multisig accounts on the keypath `m/48'/<coin>'/<account>'/2'` and
+     * # P2WSH-P2SH (wrapped segwit) multisig accounts on the keypath `m/48'/<coin>'/<account>'/1'`.
+     * # P2WSH-P2SH requires firmware v9.1.0; older firmware throws an error for which `isErrorUnsupported(err)` is true.
      *
      * @param account account object details:
      *     {
@@ -403,11 +962,14 @@ export class BitBox02API {
      *         "threshold": number, // signing threshold, e.g. 2.
      *         "xpubs": [string], // list of account-level xpubs given in any format. One of them must belong to the connected BitBox02.
      *         "ourXPubIndex": nmber, // index of the currently connected BitBox02's multisig xpub in the xpubs array, e.g. 0.
+     *         // optional, defaults to constants.messages.BTCScriptConfig_Multisig_ScriptType.P2WSH.
+     *         "scriptType": constants.messages.BTCScriptConfig_Multisig_ScriptType.P2WSH_P2SH,
      *     }
      * @param getName: async () => string - If the account is unknown to the device, this function will be called to get an
      *                 account name from the user. The resulting name must be between 1 and 30 ascii chars.
      */
     async btcMaybeRegisterScriptConfig(account, getName) {
+        setMultisigDefaults(account);
         const isRegistered = await this.firmware().js.AsyncBTCIsScriptConfigRegistered(account);
         if (!isRegistered) {
             await this.firmware().js.AsyncBTCRegisterScriptConfig(account, await getName());
@@ -421,6 +983,7 @@ export class BitBox02API {
      * @param keypath address-level keypath from the account, usually `account.keypathAccount.concat([0, address])`.
      */
     async btcDisplayAddressMultisig(account, keypath) {
+        setMultisigDefaults(account);
         const display = true;
         return this.firmware().js.AsyncBTCAddressMultisig(
             account,
@@ -441,6 +1004,8 @@ export class BitBox02API {
         outputs,
         version,
         locktime) {
+        setMultisigDefaults(account);
+        setInputDefaults(inputs);
         setOutputDefaults(outputs);
         return this.firmware().js.AsyncBTCSignMultisig(
             account,
@@ -451,6 +1016,208 @@ export class BitBox02API {
         );
     }
 
+    /**
+     * # Convert a multisig setup to a multisig account. Our xpub is found by the root fingerprint of the connected BitBox02
+     * # and checked against the xpub the BitBox02 derives at its keypath.
+     *
+     * @param coin constants.messages.BTCCoin, for example constants.messages.BTCCoin.BTC
+     * @param setup same as returned by `btcParseMultisigSetup()`.
+     * @return account object as used in `btcMaybeRegisterScriptConfig`.
+     */
+    async btcMultisigAccountFromSetup(coin, setup) {
+        setMultisigDefaults(setup);
+        return this.firmware().js.AsyncBTCMultisigConfigFromSetup(coin, setup);
+    }
+
+    /**
+     * # Convert a multisig account to a multisig setup, e.g. to export it with `btcFormatMultisigSetup()`.
+     * # The xpub at `account.ourXPubIndex` is checked to belong to the connected BitBox02.
+     *
+     * @param account same as in `btcMaybeRegisterScriptConfig`. All cosigners are assumed to use `account.keypathAccount`.
+     * @param name name of the multisig wallet.
+     * @param rootFingerprints array of 4 byte root fingerprints (Uint8Array) of the cosigners, one per xpub. The entry
+     *     at `account.ourXPubIndex` is ignored and filled in from the connected BitBox02.
+     * @return setup object as returned by `btcParseMultisigSetup()`.
+     */
+    async btcMultisigSetupFromAccount(account, name, rootFingerprints) {
+        setMultisigDefaults(account);
+        return this.firmware().js.AsyncBTCMultisigSetupFromConfig(account, name, rootFingerprints);
+    }
+
+    /**
+     * # Register a wallet policy account on the device with a user chosen name. If it is already registered, this does nothing.
+     * # A policy account must be registered before it can be used to show addresses or sign transactions.
+     * # Wallet policies are supported from firmware v9.15.0.
+     *
+     * @param account account object details:
+     *     {
+     *         "coin": constants.messages.BTCCoin, // for example constants.messages.BTCCoin.BTC
+     *         "policy": string, // wallet policy, for example "wsh(or_d(pk(@0/**),and_v(v:pkh(@1/**),older(12960))))".
+     *         "keys": [ // keys referenced by @0, @1, etc. One of them must belong to the connected BitBox02.
+     *             {
+     *                 "rootFingerprint": Uint8Array(4), // optional for keys of other signers
+     *                 "keypath": [number], // optional for keys of other signers, for example `getKeypathFromString("m/48'/0'/0'/2'")`.
+     *                 "xpub": string, // xpub given in any format.
+     *             },
+     *         ],
+     *     }
+     * @param getName: async () => string - If the account is unknown to the device, this function will be called to get an
+     *                 account name from the user. The resulting name must be between 1 and 30 ascii chars.
+     */
+    async btcMaybeRegisterPolicy(account, getName) {
+        setPolicyKeyDefaults(account.keys);
+        const isRegistered = await this.firmware().js.AsyncBTCIsPolicyRegistered(account);
+        if (!isRegistered) {
+            await this.firmware().js.AsyncBTCRegisterPolicy(account, await getName());
+        }
+    }
+
+    /**
+     * # Display a wallet policy address on the device. `btcMaybeRegisterPolicy` should be called beforehand.
+     *
+     * @param account same as in `btcMaybeRegisterPolicy`.
+     * @param keypath keypath of our key in the policy to the address, usually our key's keypath
+     *                concatenated with `[0, address]` for receive addresses.
+     */
+    async btcDisplayAddressPolicy(account, keypath) {
+        setPolicyKeyDefaults(account.keys);
+        const display = true;
+        return this.firmware().js.AsyncBTCAddressPolicy(
+            account,
+            keypath,
+            display,
+        );
+    }
+
+    /**
+     * # Sign a wallet policy transaction. `btcMaybeRegisterPolicy` should be called beforehand.
+     *
+     * The signatures are not verified before they are returned, as that would require compiling the
+     * policy to its scripts.
+     *
+     * @param account same as in `btcMaybeRegisterPolicy`.
+     * Other params and return are the same as in `btcSignSimple`. The input and change keypaths are
+     * our key's keypath in the policy concatenated with `[change, address]`. If several keys of the
+     * policy belong to the device, all inputs must be spent with the same key.
+     */
+    async btcSignPolicy(
+        account,
+        inputs,
+        outputs,
+        version,
+        locktime) {
+        setPolicyKeyDefaults(account.keys);
+        setInputDefaults(inputs);
+        setOutputDefaults(outputs);
+        return this.firmware().js.AsyncBTCSignPolicy(
+            account,
+            inputs,
+            outputs,
+            version,
+            locktime,
+        );
+    }
+
+    /**
+     * # Sign a transaction spending from and sending change to multiple accounts.
+     *
+     * Multisig accounts must be registered beforehand using `btcMaybeRegisterScriptConfig`.
+     * The signatures are verified before they are returned, except for transactions with inputs or
+     * change outputs of policy accounts, whose signatures can't be verified (see `btcSignPolicy`).
+     *
+     * @param coin Coin to target - `constants.messages.BTCCoin.*`, for example `constants.messages.BTCCoin.BTC`.
+     * @param scriptConfigs array of accounts involved in the transaction:
+     *     {
+     *       "scriptConfig": {
+     *         // One of:
+     *         "simpleType": constants.messages.BTCScriptConfig_SimpleType.P2WPKH,
+     *         "multisig": {
+     *           "threshold": number,
+     *           "xpubs": [string],
+     *           "ourXPubIndex": number,
+     *           "scriptType": number, // optional, same as in `btcMaybeRegisterScriptConfig`.
+     *         },
+     *         "policy": {
+     *           "policy": string,
+     *           "keys": [Object], // same as in `btcMaybeRegisterPolicy`.
+     *         },
+     *       },
+     *       "keypath": [number], // account-level keypath, for example `getKeypathFromString("m/84'/0'/0'")`.
+     *     }
+     * @param inputs same as in `btcSignSimple`, with the additional key
+     *     "scriptConfigIndex": number, // index into scriptConfigs of the account the input belongs to.
+     * @param outputs same as in `btcSignSimple`. Change outputs have the additional key
+     *     "scriptConfigIndex": number, // index into scriptConfigs of the account the change belongs to.
+     * @param version Transaction version, usually 1 or 2.
+     * @param locktime Transaction locktime, usually 0.
+     * @return Array of 64 byte signatures, one per input.
+     */
+    async btcSign(
+        coin,
+        scriptConfigs,
+        inputs,
+        outputs,
+        version,
+        locktime) {
+        for (const scriptConfig of scriptConfigs) {
+            if (scriptConfig.scriptConfig.multisig) {
+                setMultisigDefaults(scriptConfig.scriptConfig.multisig);
+            }
+            if (scriptConfig.scriptConfig.policy) {
+                setPolicyKeyDefaults(scriptConfig.scriptConfig.policy.keys);
+            }
+        }
+        setInputDefaults(inputs);
+        setOutputDefaults(outputs);
+        return this.firmware().js.AsyncBTCSign(
+            coin,
+            scriptConfigs,
+            inputs,
+            outputs,
+            version,
+            locktime,
+        );
+    }
+
+    /**
+     * # Sign a PSBT (BIP174).
+     *
+     * All inputs must belong to the device, identified by the root fingerprint in their BIP32
+     * derivations (PSBT_IN_BIP32_DERIVATION or PSBT_IN_TAP_BIP32_DERIVATION). Inputs of other
+     * wallets can't be skipped, as the device signs all inputs of a transaction. Supported input
+     * types are P2WPKH, P2WPKH-P2SH, P2TR (key path), and P2WSH and P2WSH-P2SH inputs of the
+     * multisig accounts passed in options.multisig. Outputs with a BIP32 derivation of the device are
+     * verified as change on the device. Non-taproot inputs need the full previous transaction
+     * (PSBT_IN_NON_WITNESS_UTXO).
+     *
+     * @param psbtBase64 base64 encoded PSBT.
+     * @param options optional object
+     *     {
+     *       "coin": constants.messages.BTCCoin.*, // defaults to constants.messages.BTCCoin.BTC.
+     *       "multisig": [account], // multisig accounts as in btcMaybeRegisterScriptConfig(), which
+     *                              // must be registered on the device. Defaults to [].
+     *     }
+     * @return the base64 encoded PSBT with the signatures added as PSBT_IN_PARTIAL_SIG or
+     *         PSBT_IN_TAP_KEY_SIG fields.
+     */
+    async btcSignPSBT(psbtBase64, options = {}) {
+        options = Object.assign({
+            coin: constants.messages.BTCCoin.BTC,
+            multisig: [],
+        }, options);
+        options.multisig.forEach(setMultisigDefaults);
+        return this.firmware().js.AsyncBTCSignPSBT(psbtBase64, options);
+    }
+
+    /**
+     * # Get the key Electrum uses to encrypt the wallet file.
+     *
+     * @param keypath must be `getKeypathFromString("m/4541509'/1112098098'")`.
+     * @return the key as an xpub string.
+     */
+    async electrumEncryptionKey(keypath) {
+        return this.firmware().js.AsyncElectrumEncryptionKey(keypath);
+    }
 
     // --- End Bitcoin methods ---
 
@@ -732,3 +1499,172 @@ export class BitBox02API {
         return this.fw;
     }
 }
+
+/**
+ * Client for the BitBox02 bootloader, used to install or upgrade the firmware.
+ *
+ * The device boots into the bootloader if no firmware is installed, or after
+ * `BitBox02API.upgradeFirmware()` was called.
+ */
+export class BitBox02BootloaderAPI {
+    /**
+     * @param devicePath See `getDevicePath()`.
+     * @param productName The USB HID product string of the device, e.g. "bb02-bootloader" or
+     *                    "bb02btc-bootloader", as listed by the BitBoxBridge. Not needed with WebHID,
+     *                    where it is read from the device chosen by the user.
+     */
+    constructor(devicePath, productName) {
+        this.devicePath = devicePath;
+        this.productName = productName;
+        this.connection = null;
+        this.onCloseCb = null;
+        this.bl = null;
+    }
+
+    // Called whenever the connection is closed, e.g. by `close()`, by unplugging the device or by a
+    // device reboot. Aborts pending device queries.
+    onClose = () => {
+        if (this.bl) {
+            this.bl.OnClose();
+        }
+        if (this.onCloseCb) {
+            this.onCloseCb();
+        }
+    }
+
+    /**
+     * @param onCloseCb Callback that's called when the connection is closed.
+     * @return Promise that will resolve once the connection is established.
+     */
+    async connect(onCloseCb) {
+        this.onCloseCb = onCloseCb;
+        const onMessage = bytes => {
+            if (this.connectionValid()) {
+                this.bootloader().OnRead(bytes);
+            }
+        };
+        const useBridge = this.devicePath !== webHID;
+        if (useBridge) {
+            this.connection = await connectWebsocket(this.devicePath, onMessage, this.onClose);
+        } else {
+            this.connection = await connectWebHID(
+                onMessage, this.onClose, productName => productName.endsWith('-bootloader'));
+        }
+        if (!this.connection) {
+            throw new Error("Could not establish a connection to the BitBox02 bootloader");
+        }
+        if (useBridge) {
+            this.bl = api.NewBootloaderBridge(this.productName, this.connection.onWrite);
+        } else {
+            this.productName = this.connection.productName;
+            this.bl = api.NewBootloaderWebHID(this.productName, this.connection.onWrite);
+        }
+
+        // Turn all Async* methods into promises.
+        for (const key in this.bl) {
+            if (key.startsWith("Async")) {
+                this.bl[key] = promisify(this.bl[key]);
+            }
+        }
+    }
+
+    /**
+     * @return The product, one of `constants.Product`.
+     */
+    product() {
+        return this.bootloader().Product();
+    }
+
+    /**
+     * # Get the versions of the installed firmware and of the firmware signing keys.
+     *
+     * The versions are monotonic counters, not semantic versions.
+     *
+     * @return Object
+     *     {
+     *         "firmwareVersion": number,
+     *         "signingPubkeysVersion": number,
+     *     }
+     */
+    async versions() {
+        return this.bootloader().AsyncVersions();
+    }
+
+    /**
+     * # Get the hashes of the installed firmware and of the signing key data.
+     *
+     * @param displayFirmwareHash if true, the firmware hash is also shown on the device.
+     * @param displaySigningKeydataHash if true, the signing key data hash is also shown on the device.
+     * @return Object
+     *     {
+     *         "firmwareHash": Uint8Array, // 32 bytes
+     *         "signingKeydataHash": Uint8Array, // 32 bytes
+     *     }
+     */
+    async getHashes(displayFirmwareHash = false, displaySigningKeydataHash = false) {
+        return this.bootloader().AsyncGetHashes(displayFirmwareHash, displaySigningKeydataHash);
+    }
+
+    /**
+     * @return True if the bootloader shows the firmware hash on every boot.
+     */
+    async showFirmwareHashEnabled() {
+        return this.bootloader().AsyncShowFirmwareHashEnabled();
+    }
+
+    /**
+     * # Enable or disable showing the firmware hash on every boot.
+     */
+    async setShowFirmwareHashEnabled(enabled) {
+        return this.bootloader().AsyncSetShowFirmwareHashEnabled(enabled);
+    }
+
+    /**
+     * # Install a signed firmware binary and reboot into it.
+     *
+     * The firmware binary must match the product, see `product()`. The bootloader only starts the
+     * new firmware if its signatures are valid.
+     *
+     * @param signedFirmware Uint8Array - signed firmware binary.
+     * @param onProgress Optional callback called with the progress between 0 and 1.
+     */
+    async upgradeFirmware(signedFirmware, onProgress = () => {}) {
+        return this.bootloader().AsyncUpgradeFirmware(signedFirmware, onProgress);
+    }
+
+    /**
+     * # Reboot the device. The bootloader starts the installed firmware if its signatures are valid.
+     */
+    async reboot() {
+        return this.bootloader().AsyncReboot();
+    }
+
+    /**
+     * @returns True if the connection has been opened and successfully established.
+     */
+    connectionValid() {
+        return this.connection && this.connection.valid();
+    }
+
+    /**
+     * @returns False if connection wasn't opened
+     */
+    close() {
+        if (!this.connectionValid()) {
+            return false;
+        }
+        this.connection.close();
+        this.connection = null;
+        return true;
+    }
+
+    /**
+     * Use the return value of this function to communicate with the bootloader.
+     */
+    bootloader() {
+        if (!this.connectionValid()) {
+            throw new Error('Device or websocket not connected')
+        }
+        return this.bl;
+    }
+}
@@ -16,8 +16,19 @@ export { getKeypathFromString } from './utils.js';
 
 export {
     BitBox02API,
+    BitBox02BootloaderAPI,
+    btcDeriveAddressMultisig,
+    btcDeriveAddressSimple,
+    btcFinalizeMultisig,
+    btcFinalizePSBT,
+    btcFinalizeSimple,
+    btcFormatMultisigSetup,
+    btcParseMultisigSetup,
+    getBIP86AccountKeypath,
     getDevicePath,
     HARDENED,
     constants,
+    isBIP86Keypath,
     isErrorAbort,
+    isErrorUnsupported,
 } from './bitbox02.js';
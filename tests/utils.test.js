@@ -1,5 +1,5 @@
 import { getChainIDFromKeypath, getKeypathFromString, getCoinFromChainId } from '../src/utils.js';
-import { constants, HARDENED } from '../src/index.js';
+import { constants, getBIP86AccountKeypath, HARDENED, isBIP86Keypath } from '../src/index.js';
 
 /**
  * Test getCoinFromChainId
@@ -106,3 +106,46 @@ test("Wallet other than 60' or 1' throws 'Invalid keypath'", () => {
 test("keypath as string throws, must be Uint8Array", () => {
     expect(() => getChainIDFromKeypath("m/44'/60'/0'/0")).toThrow();
 })
+
+
+/**
+ * Test getBIP86AccountKeypath
+ */
+test("Return BIP86 account keypaths for BTC and TBTC", () => {
+    expect(getBIP86AccountKeypath(constants.messages.BTCCoin.BTC, 0)).toEqual(getKeypathFromString("m/86'/0'/0'"));
+    expect(getBIP86AccountKeypath(constants.messages.BTCCoin.TBTC, 3)).toEqual(getKeypathFromString("m/86'/1'/3'"));
+})
+
+test("Taproot is not supported for Litecoin", () => {
+    expect(() => getBIP86AccountKeypath(constants.messages.BTCCoin.LTC, 0)).toThrow(expect.objectContaining({ Message: 'taproot is not supported for LTC' }));
+})
+
+test("Account must be a non-negative integer below HARDENED", () => {
+    expect(() => getBIP86AccountKeypath(constants.messages.BTCCoin.BTC, -1)).toThrow(expect.objectContaining({ Message: 'invalid account' }));
+    expect(() => getBIP86AccountKeypath(constants.messages.BTCCoin.BTC, HARDENED)).toThrow(expect.objectContaining({ Message: 'invalid account' }));
+    expect(() => getBIP86AccountKeypath(constants.messages.BTCCoin.BTC, '0')).toThrow(expect.objectContaining({ Message: 'invalid account' }));
+})
+
+/**
+ * Test isBIP86Keypath
+ */
+test("Valid BIP86 account and address keypaths", () => {
+    expect(isBIP86Keypath(constants.messages.BTCCoin.BTC, getKeypathFromString("m/86'/0'/0'"))).toBe(true);
+    expect(isBIP86Keypath(constants.messages.BTCCoin.BTC, getKeypathFromString("m/86'/0'/0'/0/5"))).toBe(true);
+    expect(isBIP86Keypath(constants.messages.BTCCoin.TBTC, getKeypathFromString("m/86'/1'/2'/1/0"))).toBe(true);
+})
+
+test("Invalid BIP86 keypaths", () => {
+    // wrong purpose
+    expect(isBIP86Keypath(constants.messages.BTCCoin.BTC, getKeypathFromString("m/84'/0'/0'/0/5"))).toBe(false);
+    // coin type does not match the coin
+    expect(isBIP86Keypath(constants.messages.BTCCoin.BTC, getKeypathFromString("m/86'/1'/0'/0/5"))).toBe(false);
+    expect(isBIP86Keypath(constants.messages.BTCCoin.LTC, getKeypathFromString("m/86'/2'/0'/0/5"))).toBe(false);
+    // unhardened account
+    expect(isBIP86Keypath(constants.messages.BTCCoin.BTC, getKeypathFromString("m/86'/0'/0/0/5"))).toBe(false);
+    // invalid change or address
+    expect(isBIP86Keypath(constants.messages.BTCCoin.BTC, getKeypathFromString("m/86'/0'/0'/2/5"))).toBe(false);
+    expect(isBIP86Keypath(constants.messages.BTCCoin.BTC, getKeypathFromString("m/86'/0'/0'/0/5'"))).toBe(false);
+    // wrong length
+    expect(isBIP86Keypath(constants.messages.BTCCoin.BTC, getKeypathFromString("m/86'/0'/0'/0"))).toBe(false);
+})